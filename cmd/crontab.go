@@ -0,0 +1,146 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cronparser/cron"
+)
+
+// runCrontab prints the expanded table of every job in a crontab file, of
+// standard input if the file is "-", or of every file in an /etc/cron.d
+// style directory, e.g.
+//
+//	cronparser crontab /var/spool/cron/crontabs/alice
+//	cronparser crontab --system /etc/cron.d
+func runCrontab(args []string) error {
+	flags := flag.NewFlagSet("crontab", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	parserOpts := registerParserFlags(flags)
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return fmt.Errorf("error in parsing arguments: %s", err)
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("expected exactly one crontab file or directory, got %d\n%s", len(positional), usage)
+	}
+
+	opts, err := parserOpts.options()
+	if err != nil {
+		return err
+	}
+
+	parser := cron.New(opts...)
+	path := positional[0]
+
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return printCrontabDir(parser, path)
+	}
+
+	crontab, err := readCrontab(parser, path)
+
+	var crontabErr *cron.CrontabError
+	if err != nil && !errors.As(err, &crontabErr) {
+		return err
+	}
+
+	printCrontab(crontab)
+
+	if crontabErr != nil {
+		return fmt.Errorf("error in parsing crontab: %s\n%s", path, crontabErr)
+	}
+
+	return nil
+}
+
+// printCrontabDir prints the crontabs of a directory one after the other,
+// followed by the errors of every invalid file.
+func printCrontabDir(parser *cron.Parser, dir string) error {
+	files, err := parser.ParseCrontabDir(dir)
+	if err != nil {
+		return fmt.Errorf("error in reading crontab directory: %s, err: %s", dir, err)
+	}
+
+	var invalid []string
+
+	for i, file := range files {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		fmt.Printf("%-14s%s\n", "file", file.Path)
+
+		if file.Crontab != nil && len(file.Crontab.Entries) > 0 {
+			fmt.Println()
+			printCrontab(file.Crontab)
+		}
+
+		if file.Err != nil {
+			invalid = append(invalid, fmt.Sprintf("error in parsing crontab: %s\n%s", file.Path, file.Err))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return errors.New(strings.Join(invalid, "\n"))
+	}
+
+	return nil
+}
+
+// printCrontab prints the table of every entry of a crontab, separated by
+// blank lines.
+func printCrontab(crontab *cron.Crontab) {
+	for i, entry := range crontab.Entries {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		fmt.Printf("%-14s%d\n", "line", entry.Line)
+		printSchedule(entry.Schedule)
+		printEnv(entry.Env)
+	}
+}
+
+// readCrontab parses the crontab file at path, "-" being standard input.
+func readCrontab(parser *cron.Parser, path string) (*cron.Crontab, error) {
+	if path == "-" {
+		return parser.ParseCrontab(os.Stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error in reading crontab: %s, err: %s", path, err)
+	}
+	defer file.Close()
+
+	return parser.ParseCrontab(file)
+}
+
+// printEnv prints the environment of a crontab entry sorted by name.
+func printEnv(env map[string]string) {
+	if len(env) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	assignments := make([]string, len(names))
+	for i, name := range names {
+		assignments[i] = name + "=" + env[name]
+	}
+
+	fmt.Printf("%-14s%s\n", "environment", strings.Join(assignments, " "))
+}
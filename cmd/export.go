@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cronparser/cron"
+	"github.com/cronparser/export"
+)
+
+// runExport converts a crontab into the jobs of another scheduler, e.g.
+//
+//	cronparser export systemd --output /etc/systemd/system /var/spool/cron/crontabs/alice
+//	cronparser export kubernetes --image busybox:1.36 /var/spool/cron/crontabs/alice
+func runExport(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("expected an export format: systemd or kubernetes\n%s", usage)
+	}
+
+	switch args[0] {
+	case "systemd":
+		return runExportSystemd(args[1:])
+	case "kubernetes":
+		return runExportKubernetes(args[1:])
+	default:
+		return fmt.Errorf("unknown export format %q, expected systemd or kubernetes", args[0])
+	}
+}
+
+// runExportSystemd writes a .timer and a .service unit for every entry of a
+// crontab into the output directory and prints their paths. Nothing is
+// written if an entry cannot be parsed or converted.
+func runExportSystemd(args []string) error {
+	flags := flag.NewFlagSet("export systemd", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	parserOpts := registerParserFlags(flags)
+	output := flags.String("output", ".", "directory to write the unit files to")
+	name := flags.String("name", "", "prefix of the unit names, defaults to the crontab file name")
+	user := flags.String("user", "", "user to run the commands as, defaults to the crontab file name unless --system is given")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return fmt.Errorf("error in parsing arguments: %s", err)
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("expected exactly one crontab file, got %d\n%s", len(positional), usage)
+	}
+
+	path := positional[0]
+
+	// user crontabs are named after their owner, e.g. /var/spool/cron/crontabs/alice
+	owner := *user
+	if owner == "" && !*parserOpts.system && path != "-" {
+		owner = filepath.Base(path)
+	}
+
+	crontab, err := readExportCrontab(parserOpts, path)
+	if err != nil {
+		return err
+	}
+
+	prefix := *name
+	if prefix == "" {
+		prefix = unitPrefix(path)
+	}
+
+	units, err := export.SystemdCrontab(prefix, owner, crontab)
+	if err != nil {
+		return fmt.Errorf("error in exporting crontab: %s\n%s", path, err)
+	}
+
+	if err := os.MkdirAll(*output, 0o755); err != nil {
+		return fmt.Errorf("error in creating output directory: %s, err: %s", *output, err)
+	}
+
+	for _, unit := range units {
+		files := []struct{ path, content string }{
+			{filepath.Join(*output, unit.Name+".timer"), unit.Timer},
+			{filepath.Join(*output, unit.Name+".service"), unit.Service},
+		}
+
+		for _, file := range files {
+			if err := os.WriteFile(file.path, []byte(file.content), 0o644); err != nil {
+				return fmt.Errorf("error in writing unit file: %s, err: %s", file.path, err)
+			}
+
+			fmt.Println(file.path)
+		}
+	}
+
+	return nil
+}
+
+// runExportKubernetes writes a batch/v1 CronJob manifest for every entry of
+// a crontab to standard output, or to the file given with --output. Nothing
+// is written if an entry cannot be parsed or converted.
+func runExportKubernetes(args []string) error {
+	flags := flag.NewFlagSet("export kubernetes", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	parserOpts := registerParserFlags(flags)
+	output := flags.String("output", "-", "file to write the manifests to, - for standard output")
+	name := flags.String("name", "", "prefix of the CronJob names, defaults to the crontab file name")
+	image := flags.String("image", "", "container image to run the commands in")
+	namespace := flags.String("namespace", "", "namespace of the CronJobs")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return fmt.Errorf("error in parsing arguments: %s", err)
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("expected exactly one crontab file, got %d\n%s", len(positional), usage)
+	}
+
+	if *image == "" {
+		return fmt.Errorf("--image is required\n%s", usage)
+	}
+
+	path := positional[0]
+
+	crontab, err := readExportCrontab(parserOpts, path)
+	if err != nil {
+		return err
+	}
+
+	prefix := *name
+	if prefix == "" {
+		prefix = strings.ToLower(strings.ReplaceAll(unitPrefix(path), "_", "-"))
+	}
+
+	manifests, err := export.KubernetesCrontab(prefix, crontab, export.KubernetesOptions{Image: *image, Namespace: *namespace})
+	if err != nil {
+		return fmt.Errorf("error in exporting crontab: %s\n%s", path, err)
+	}
+
+	if *output == "-" {
+		_, err = os.Stdout.Write(manifests)
+
+		return err
+	}
+
+	if err := os.WriteFile(*output, manifests, 0o644); err != nil {
+		return fmt.Errorf("error in writing manifests: %s, err: %s", *output, err)
+	}
+
+	return nil
+}
+
+// readExportCrontab parses the crontab to export, refusing it if any line
+// is invalid.
+func readExportCrontab(parserOpts *parserFlags, path string) (*cron.Crontab, error) {
+	opts, err := parserOpts.options()
+	if err != nil {
+		return nil, err
+	}
+
+	crontab, err := readCrontab(cron.New(opts...), path)
+
+	var crontabErr *cron.CrontabError
+	if errors.As(err, &crontabErr) {
+		return nil, fmt.Errorf("error in parsing crontab: %s\n%s", path, crontabErr)
+	}
+
+	return crontab, err
+}
+
+// unitPrefix names the units of a crontab after its file, "cron" for
+// standard input.
+func unitPrefix(path string) string {
+	if path == "-" {
+		return "cron"
+	}
+
+	return filepath.Base(path)
+}
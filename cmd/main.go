@@ -1,30 +1,300 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/cronparser/internal/parser"
+	"github.com/cronparser/cron"
 )
 
-func main() {
-	// Usage information
-	usage := "Usage: ./cronparser \"*/15 0 1,15 * 1-5 /usr/bin/find\""
+// Usage information
+const usage = `Usage:
+  ./cronparser [--seconds] [--year] [--seed name] [--wrap] [--start 2026-10-17T00:00:00Z] "*/15 0 1,15 * 1-5 /usr/bin/find"
+  ./cronparser "cron(0 12 ? * MON-FRI *)"
+  ./cronparser next [--seconds] [--year] [--seed name] [--wrap] [--dialect vixie] "*/15 0 1,15 * 1-5" [--count 5] [--from 2026-10-17T00:00:00Z] [--tz Europe/London]
+  ./cronparser crontab [--seconds] [--year] [--seed name] [--wrap] [--system] [--dialect vixie] /var/spool/cron/crontabs/alice
+  ./cronparser crontab --system /etc/cron.d
+  ./cronparser oncalendar [--seconds] [--year] [--dialect vixie] "0 9 * * 1-5"
+  ./cronparser oncalendar --parse "Mon..Fri *-*-* 09:00"
+  ./cronparser export systemd [--system] [--output /etc/systemd/system] [--name alice] [--user alice] /var/spool/cron/crontabs/alice
+  ./cronparser export kubernetes --image busybox:1.36 [--namespace batch] [--output cronjobs.yaml] /var/spool/cron/crontabs/alice`
 
+func main() {
 	if len(os.Args) < 2 {
 		fmt.Println(usage)
 
 		os.Exit(1)
 	}
 
-	cronExpr := os.Args[1]
+	var err error
+
+	switch os.Args[1] {
+	case "next":
+		err = runNext(os.Args[2:])
+	case "crontab":
+		err = runCrontab(os.Args[2:])
+	case "oncalendar":
+		err = runOnCalendar(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	default:
+		err = runTable(os.Args[1:])
+	}
 
-	cronParser := parser.New()
+	if err != nil {
+		fmt.Println(err.Error())
+
+		os.Exit(1)
+	}
+}
+
+// runTable prints the expanded fields of a cron expression followed by a
+// command, or of an EventBridge cron(...) or rate(...) expression.
+func runTable(args []string) error {
+	flags := flag.NewFlagSet("cronparser", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	parserOpts := registerParserFlags(flags)
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return fmt.Errorf("error in parsing arguments: %s", err)
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("expected exactly one cron expression, got %d\n%s", len(positional), usage)
+	}
+
+	cronExpr := positional[0]
+
+	eventBridge := isEventBridge(cronExpr)
+	if eventBridge {
+		parserOpts.defaultDialect(cron.AWS.Name)
+	}
+
+	opts, err := parserOpts.options()
+	if err != nil {
+		return err
+	}
+
+	cronParser := cron.New(opts...)
+
+	// EventBridge rules have a target instead of a command
+	parse := cronParser.Parse
+	if eventBridge {
+		parse = cronParser.ParseExpression
+	}
 
-	err := cronParser.Parse(cronExpr)
+	schedule, err := parse(cronExpr)
 	if err != nil {
-		fmt.Printf("error in parsing input: %s, err: %s", cronExpr, err.Error())
+		return fmt.Errorf("error in parsing input: %s, err: %s", cronExpr, err.Error())
+	}
+
+	printSchedule(schedule)
+
+	if eventBridge {
+		printUpcomingUTC(schedule, time.Now(), eventBridgeRuns)
+	}
+
+	return nil
+}
+
+// eventBridgeRuns is the number of upcoming runs the table shows for an
+// EventBridge expression.
+const eventBridgeRuns = 5
+
+// printUpcomingUTC prints the next count fire times of the schedule after
+// from in UTC, the time zone EventBridge evaluates its schedules in.
+func printUpcomingUTC(schedule *cron.Schedule, from time.Time, count int) {
+	label := "next (UTC)"
+
+	for _, next := range schedule.NextN(from.UTC(), count) {
+		fmt.Printf("%-14s%s\n", label, next.UTC().Format(time.RFC3339))
+		label = ""
+	}
+}
+
+// printSchedule writes the expanded schedule as a table, one row per field.
+func printSchedule(schedule *cron.Schedule) {
+	if schedule.Kind() == cron.KindReboot {
+		fmt.Printf("%-14s%s\n", "schedule", "@reboot, once at startup")
+		printCommand(schedule)
+
+		return
+	}
+
+	if schedule.Kind() == cron.KindInterval {
+		fmt.Printf("%-14s%s\n", "schedule", "every "+schedule.Interval().String())
+		fmt.Printf("%-14s%s\n", "starting", schedule.Anchor().Format(time.RFC3339))
+		printTimeZone(schedule)
+		printCommand(schedule)
+
+		return
+	}
+
+	for _, field := range schedule.Fields() {
+		if schedule.NoSpecificValue(field) {
+			fmt.Printf("%-14s%s\n", field, "? (no specific value)")
+			continue
+		}
+
+		values := append(formatValues(schedule.Values(field)), schedule.Specials(field)...)
+
+		// values picked by the parser are shown with the token they came from
+		if token := schedule.Token(field); strings.Contains(token, "~") {
+			values = append(values, "("+token+")")
+		}
+
+		fmt.Printf("%-14s%s\n", field, strings.Join(values, " "))
+	}
+
+	fmt.Printf("%-14s%s\n", "day matching", describeDayMatching(schedule))
+	printTimeZone(schedule)
+	printCommand(schedule)
+}
+
+// printCommand prints the user of a system crontab entry, if any, and the
+// command, if the schedule has one.
+func printCommand(schedule *cron.Schedule) {
+	if schedule.User() != "" {
+		fmt.Printf("%-14s%s\n", "user", schedule.User())
+	}
+
+	if schedule.Command() != "" {
+		fmt.Printf("%-14s%s\n", "command", schedule.Command())
+	}
+}
+
+// printTimeZone prints the time zone set by a CRON_TZ= or TZ= prefix, if any.
+func printTimeZone(schedule *cron.Schedule) {
+	if schedule.Location() != nil {
+		fmt.Printf("%-14s%s\n", "time zone", schedule.Location())
+	}
+}
+
+func formatValues(values []int) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = strconv.Itoa(value)
+	}
+
+	return result
+}
+
+// describeDayMatching explains how the day of month and day of week fields
+// combine for the schedule.
+func describeDayMatching(schedule *cron.Schedule) string {
+	if schedule.MatchesEitherDay() {
+		return "day of month OR day of week"
 	}
 
-	return
+	return "day of month AND day of week"
+}
+
+// parserFlags are the command line flags that configure the parser.
+type parserFlags struct {
+	seconds *bool
+	year    *bool
+	start   *string
+	seed    *string
+	wrap    *bool
+	system  *bool
+	dialect *string
+}
+
+func registerParserFlags(flags *flag.FlagSet) *parserFlags {
+	return &parserFlags{
+		seconds: flags.Bool("seconds", false, "expect a leading seconds field"),
+		year:    flags.Bool("year", false, "expect a trailing year field"),
+		start:   flags.String("start", "", "RFC 3339 time @every intervals are anchored to, defaults to the Unix epoch"),
+		seed:    flags.String("seed", "", "seed for H hash tokens, e.g. the job name"),
+		wrap:    flags.Bool("wrap", false, "allow wrap-around ranges such as 22-2"),
+		system:  flags.Bool("system", false, "expect a user between the expression and the command, as in /etc/crontab"),
+		dialect: flags.String("dialect", "", "cron syntax to read: "+strings.Join(cron.DialectNames(), ", ")),
+	}
+}
+
+// defaultDialect selects a dialect unless one was given with --dialect.
+func (f *parserFlags) defaultDialect(name string) {
+	if *f.dialect == "" {
+		*f.dialect = name
+	}
+}
+
+func (f *parserFlags) options() ([]cron.Option, error) {
+	var opts []cron.Option
+
+	// the dialect goes first so that the other flags can adjust it
+	if *f.dialect != "" {
+		dialect, err := cron.LookupDialect(*f.dialect)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, cron.WithDialect(dialect))
+	}
+
+	if *f.seconds {
+		opts = append(opts, cron.WithSeconds())
+	}
+
+	if *f.year {
+		opts = append(opts, cron.WithYear())
+	}
+
+	if *f.wrap {
+		opts = append(opts, cron.WithWrapAround())
+	}
+
+	if *f.system {
+		opts = append(opts, cron.WithSystemCrontab())
+	}
+
+	if *f.seed != "" {
+		opts = append(opts, cron.WithHashSeed(*f.seed))
+	}
+
+	if *f.start != "" {
+		start, err := time.Parse(time.RFC3339, *f.start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval start: %s, err: %s", *f.start, err)
+		}
+
+		opts = append(opts, cron.WithIntervalStart(start))
+	}
+
+	return opts, nil
+}
+
+// isEventBridge reports whether expr is written in the cron(...) or
+// rate(...) form of Amazon EventBridge.
+func isEventBridge(expr string) bool {
+	trimmed := strings.TrimSpace(expr)
+
+	return strings.HasPrefix(trimmed, "cron(") || strings.HasPrefix(trimmed, "rate(")
+}
+
+// parseInterspersed parses flags that may appear before or after positional
+// arguments and returns the positional arguments.
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		err := flags.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if flags.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
 }
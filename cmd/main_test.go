@@ -0,0 +1,69 @@
+package main
+
+import (
+	"github.com/cronparser/cron"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestPrintUpcomingUTC(t *testing.T) {
+	from, err := time.Parse(time.RFC3339, "2026-10-17T09:00:00+02:00")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		input     string
+		expOutput string
+	}{
+		{
+			input: "cron(0 12 ? * MON-FRI *)",
+			expOutput: "next (UTC)    2026-10-19T12:00:00Z\n" +
+				"              2026-10-20T12:00:00Z\n" +
+				"              2026-10-21T12:00:00Z\n",
+		},
+		{
+			input: "rate(5 minutes)",
+			expOutput: "next (UTC)    2026-10-17T07:05:00Z\n" +
+				"              2026-10-17T07:10:00Z\n" +
+				"              2026-10-17T07:15:00Z\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			schedule, err := cron.New(cron.WithDialect(cron.AWS)).ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			output := captureStdout(t, func() {
+				printUpcomingUTC(schedule, from, 3)
+			})
+
+			assert.Equal(t, test.expOutput, output)
+		})
+	}
+}
+
+// captureStdout returns what fn writes to standard output.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	assert.Nil(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = w
+
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	fn()
+	assert.Nil(t, w.Close())
+
+	output, err := io.ReadAll(r)
+	assert.Nil(t, err)
+
+	return string(output)
+}
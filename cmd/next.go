@@ -0,0 +1,87 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cronparser/cron"
+)
+
+// runNext prints the upcoming fire times of a cron expression, e.g.
+//
+//	cronparser next "*/15 0 1,15 * 1-5" --count 5 --tz Europe/London
+func runNext(args []string) error {
+	flags := flag.NewFlagSet("next", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	parserOpts := registerParserFlags(flags)
+	count := flags.Int("count", 5, "number of fire times to print")
+	from := flags.String("from", "", "RFC 3339 time to start from, defaults to now")
+	tz := flags.String("tz", "", "IANA time zone to print fire times in, defaults to the schedule's CRON_TZ or the local zone; without a CRON_TZ the schedule is also evaluated in it")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return fmt.Errorf("error in parsing arguments: %s", err)
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("expected exactly one cron expression, got %d\n%s", len(positional), usage)
+	}
+
+	if *count <= 0 {
+		return fmt.Errorf("count must be positive, got %d", *count)
+	}
+
+	start := time.Now()
+	if *from != "" {
+		start, err = time.Parse(time.RFC3339, *from)
+		if err != nil {
+			return fmt.Errorf("invalid start time: %s, err: %s", *from, err)
+		}
+	}
+
+	if isEventBridge(positional[0]) {
+		parserOpts.defaultDialect(cron.AWS.Name)
+	}
+
+	opts, err := parserOpts.options()
+	if err != nil {
+		return err
+	}
+
+	schedule, err := cron.New(opts...).ParseExpression(positional[0])
+	if err != nil {
+		return fmt.Errorf("error in parsing input: %s, err: %s", positional[0], err)
+	}
+
+	loc := time.Local
+	if schedule.Location() != nil {
+		loc = schedule.Location()
+	}
+
+	if *tz != "" {
+		loc, err = time.LoadLocation(*tz)
+		if err != nil {
+			return fmt.Errorf("invalid time zone: %s, err: %s", *tz, err)
+		}
+	}
+
+	if schedule.Kind() == cron.KindReboot {
+		fmt.Println("@reboot runs once at startup and has no time based fire times")
+
+		return nil
+	}
+
+	upcoming := schedule.NextN(start.In(loc), *count)
+	if len(upcoming) == 0 {
+		fmt.Println("the schedule never fires after", start.In(loc).Format(time.RFC3339))
+	}
+
+	for _, next := range upcoming {
+		fmt.Println(next.Format(time.RFC3339))
+	}
+
+	return nil
+}
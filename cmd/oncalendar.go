@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/cronparser/cron"
+)
+
+// runOnCalendar prints the systemd OnCalendar= lines of a cron expression,
+// or with --parse the expanded fields of an OnCalendar expression, e.g.
+//
+//	cronparser oncalendar "0 9 * * 1-5"
+//	cronparser oncalendar --parse "Mon..Fri *-*-* 09:00"
+func runOnCalendar(args []string) error {
+	flags := flag.NewFlagSet("oncalendar", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	parserOpts := registerParserFlags(flags)
+	parse := flags.Bool("parse", false, "read an OnCalendar expression and print its fields")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return fmt.Errorf("error in parsing arguments: %s", err)
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("expected exactly one expression, got %d\n%s", len(positional), usage)
+	}
+
+	if *parse {
+		schedule, err := cron.ParseOnCalendar(positional[0])
+		if err != nil {
+			return fmt.Errorf("error in parsing input: %s, err: %s", positional[0], err)
+		}
+
+		printSchedule(schedule)
+
+		return nil
+	}
+
+	if isEventBridge(positional[0]) {
+		parserOpts.defaultDialect(cron.AWS.Name)
+	}
+
+	opts, err := parserOpts.options()
+	if err != nil {
+		return err
+	}
+
+	schedule, err := cron.New(opts...).ParseExpression(positional[0])
+	if err != nil {
+		return fmt.Errorf("error in parsing input: %s, err: %s", positional[0], err)
+	}
+
+	lines, err := schedule.OnCalendar()
+	if err != nil {
+		return fmt.Errorf("error in converting input: %s, err: %s", positional[0], err)
+	}
+
+	for _, line := range lines {
+		fmt.Println("OnCalendar=" + line)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package cron
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cronTZ is the environment variable that sets the time zone of the
+// entries after it.
+const cronTZ = "CRON_TZ"
+
+// Crontab is a parsed crontab file.
+type Crontab struct {
+	// Entries are the job lines of the file, in the order they appear.
+	Entries []Entry
+}
+
+// Entry is a job line of a crontab.
+type Entry struct {
+	// Line is the line number of the job in the file, starting at 1.
+	Line int
+	// Env holds the NAME=value assignments made above the job.
+	Env map[string]string
+	// Schedule is the parsed job line.
+	Schedule *Schedule
+}
+
+// LineError reports a line of a crontab that could not be parsed.
+type LineError struct {
+	// Line is the line number, starting at 1.
+	Line int
+	// Err is the underlying reason.
+	Err error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// CrontabError lists every line of a crontab that could not be parsed.
+type CrontabError struct {
+	Errors []*LineError
+}
+
+func (e *CrontabError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// ParseCrontab reads a user crontab. Blank lines and lines starting with #
+// are skipped, NAME=value lines set the environment of the jobs below them
+// and every other line is parsed as a job with Parse. A CRON_TZ assignment
+// also sets the time zone of the jobs below it that have no CRON_TZ= prefix
+// of their own.
+//
+// Lines that cannot be parsed do not stop the parsing. They are reported
+// together as a *CrontabError, alongside a Crontab holding the valid jobs.
+func (p *Parser) ParseCrontab(r io.Reader) (*Crontab, error) {
+	crontab := &Crontab{}
+	env := map[string]string{}
+
+	var (
+		loc     *time.Location
+		invalid []*LineError
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		if name, value, ok := parseEnv(text); ok {
+			if name == cronTZ {
+				zone, err := loadZone(value)
+				if err != nil {
+					invalid = append(invalid, &LineError{Line: line, Err: err})
+					continue
+				}
+
+				loc = zone
+			}
+
+			env[name] = value
+			continue
+		}
+
+		schedule, err := p.Parse(text)
+		if err != nil {
+			invalid = append(invalid, &LineError{Line: line, Err: err})
+			continue
+		}
+
+		if schedule.location == nil {
+			schedule.location = loc
+		}
+
+		crontab.Entries = append(crontab.Entries, Entry{
+			Line:     line,
+			Env:      copyEnv(env),
+			Schedule: schedule,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(invalid) > 0 {
+		return crontab, &CrontabError{Errors: invalid}
+	}
+
+	return crontab, nil
+}
+
+// CrontabFile is a crontab read from a directory by ParseCrontabDir.
+type CrontabFile struct {
+	// Path is the path of the file.
+	Path string
+	// Crontab holds the valid jobs of the file, it is nil if the file
+	// could not be read.
+	Crontab *Crontab
+	// Err is the error reading or parsing the file, a *CrontabError if
+	// some of its lines are invalid.
+	Err error
+}
+
+// ParseCrontabDir parses every crontab in an /etc/cron.d style directory,
+// in the order of their names. Like cron it skips subdirectories and files
+// whose names contain anything but letters, digits, "_" and "-", such as
+// editor backups and package manager leftovers ("job~", "job.dpkg-old").
+// Those directories hold system crontabs, so the parser is usually created
+// WithSystemCrontab.
+//
+// Errors in a file are reported in its CrontabFile and do not stop the
+// other files from being parsed, ParseCrontabDir only fails if dir cannot
+// be read.
+func (p *Parser) ParseCrontabDir(dir string) ([]CrontabFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []CrontabFile
+
+	for _, entry := range entries {
+		if entry.IsDir() || !isCrontabName(entry.Name()) {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		crontab, err := p.parseCrontabFile(path)
+
+		files = append(files, CrontabFile{Path: path, Crontab: crontab, Err: err})
+	}
+
+	return files, nil
+}
+
+func (p *Parser) parseCrontabFile(path string) (*Crontab, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return p.ParseCrontab(file)
+}
+
+// isCrontabName reports whether cron reads a file of the given name from a
+// crontab directory.
+func isCrontabName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseEnv splits an environment assignment such as `MAILTO = "ops"` into
+// its name and unquoted value. It reports false for job lines, including
+// jobs with a CRON_TZ= or TZ= prefix.
+func parseEnv(line string) (string, string, bool) {
+	name, value, found := strings.Cut(line, "=")
+	if !found {
+		return "", "", false
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsAny(name, " \t") {
+		return "", "", false
+	}
+
+	value = unquote(strings.TrimSpace(value))
+
+	if (name == cronTZ || name == "TZ") && strings.ContainsAny(value, " \t") {
+		return "", "", false
+	}
+
+	return name, value, true
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// loadZone returns the location a CRON_TZ assignment names, nil for an
+// empty one.
+func loadZone(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalidExpression, name)
+	}
+
+	return loc, nil
+}
+
+func copyEnv(env map[string]string) map[string]string {
+	result := make(map[string]string, len(env))
+	for name, value := range env {
+		result[name] = value
+	}
+
+	return result
+}
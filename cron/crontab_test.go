@@ -0,0 +1,199 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const userCrontab = `# m h dom mon dow command
+SHELL=/bin/bash
+MAILTO = "ops@example.com"
+
+*/15 0 1,15 * 1-5	/usr/bin/find
+  # indented comment
+@daily /usr/bin/backup --full
+PATH='/usr/local/bin:/usr/bin'
+0 9 * * MON-FRI FOO=bar /usr/bin/report
+`
+
+func TestParseCrontab(t *testing.T) {
+	crontab, err := New().ParseCrontab(strings.NewReader(userCrontab))
+	assert.Nil(t, err)
+	assert.Len(t, crontab.Entries, 3)
+
+	tests := []struct {
+		msg        string
+		expLine    int
+		expEnv     map[string]string
+		expCommand string
+	}{
+		{
+			msg:        "job separated by a tab",
+			expLine:    5,
+			expEnv:     map[string]string{"SHELL": "/bin/bash", "MAILTO": "ops@example.com"},
+			expCommand: "/usr/bin/find",
+		},
+		{
+			msg:        "macro job",
+			expLine:    7,
+			expEnv:     map[string]string{"SHELL": "/bin/bash", "MAILTO": "ops@example.com"},
+			expCommand: "/usr/bin/backup --full",
+		},
+		{
+			msg:        "job after a quoted assignment",
+			expLine:    9,
+			expEnv:     map[string]string{"SHELL": "/bin/bash", "MAILTO": "ops@example.com", "PATH": "/usr/local/bin:/usr/bin"},
+			expCommand: "FOO=bar /usr/bin/report",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			entry := crontab.Entries[i]
+
+			assert.Equal(t, test.expLine, entry.Line)
+			assert.Equal(t, test.expEnv, entry.Env)
+			assert.Equal(t, test.expCommand, entry.Schedule.Command())
+		})
+	}
+
+	assert.Equal(t, []int{0, 15, 30, 45}, crontab.Entries[0].Schedule.Values(Minute))
+	assert.Equal(t, "@daily", crontab.Entries[1].Schedule.Macro())
+}
+
+func TestParseCrontabTimeZone(t *testing.T) {
+	input := `CRON_TZ=America/New_York
+0 9 * * * /usr/bin/report
+CRON_TZ=Asia/Tokyo 0 9 * * * /usr/bin/report
+CRON_TZ=
+0 9 * * * /usr/bin/report
+`
+
+	crontab, err := New().ParseCrontab(strings.NewReader(input))
+	if err != nil {
+		t.Skipf("time zone database not available: %s", err)
+	}
+
+	assert.Len(t, crontab.Entries, 3)
+	assert.Equal(t, "America/New_York", crontab.Entries[0].Schedule.Location().String())
+	assert.Equal(t, "Asia/Tokyo", crontab.Entries[1].Schedule.Location().String())
+	assert.Nil(t, crontab.Entries[2].Schedule.Location())
+	assert.Equal(t, "America/New_York", crontab.Entries[1].Env["CRON_TZ"])
+}
+
+func TestParseCrontabErrors(t *testing.T) {
+	input := `MAILTO=ops
+0 9 * * * /usr/bin/report
+0 25 * * * /usr/bin/broken
+*/5 * * *
+@hourly /usr/bin/ping
+`
+
+	crontab, err := New().ParseCrontab(strings.NewReader(input))
+
+	var crontabErr *CrontabError
+	assert.True(t, errors.As(err, &crontabErr))
+	assert.Len(t, crontabErr.Errors, 2)
+	assert.Equal(t, 3, crontabErr.Errors[0].Line)
+	assert.Equal(t, 4, crontabErr.Errors[1].Line)
+
+	var parseErr *ParseError
+	assert.ErrorAs(t, crontabErr.Errors[0], &parseErr)
+	assert.Equal(t, Hour, parseErr.Field)
+	assert.ErrorIs(t, crontabErr.Errors[1], ErrInvalidExpression)
+	assert.ErrorContains(t, err, "line 3: error in expanding cron expression")
+
+	// the valid jobs are still returned
+	assert.Len(t, crontab.Entries, 2)
+	assert.Equal(t, 2, crontab.Entries[0].Line)
+	assert.Equal(t, 5, crontab.Entries[1].Line)
+}
+
+func TestParseSystemCrontab(t *testing.T) {
+	cron := New(WithSystemCrontab())
+
+	tests := []struct {
+		msg        string
+		input      string
+		expUser    string
+		expCommand string
+	}{
+		{"fields", "17 * * * * root cd / && run-parts --report /etc/cron.hourly", "root", "cd / && run-parts --report /etc/cron.hourly"},
+		{"tab separated", "0 4\t* * *\twww-data\t/usr/bin/php /var/www/cron.php", "www-data", "/usr/bin/php /var/www/cron.php"},
+		{"macro", "@daily backup /usr/bin/backup --full", "backup", "/usr/bin/backup --full"},
+		{"interval", "@every 90m monitor /usr/bin/ping", "monitor", "/usr/bin/ping"},
+		{"reboot", "@reboot root /usr/sbin/warmup", "root", "/usr/sbin/warmup"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.Parse(test.input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expUser, schedule.User())
+			assert.Equal(t, test.expCommand, schedule.Command())
+		})
+	}
+
+	_, err := cron.Parse("0 4 * * * root")
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	schedule, err := New().Parse("0 4 * * * root /usr/sbin/logrotate")
+	assert.Nil(t, err)
+	assert.Equal(t, "", schedule.User())
+	assert.Equal(t, "root /usr/sbin/logrotate", schedule.Command())
+}
+
+func TestParseCrontabDir(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"logrotate":        "MAILTO=ops\n0 4 * * * root /usr/sbin/logrotate /etc/logrotate.conf\n",
+		"php":              "09,39 * * * * root [ -x /usr/lib/php/sessionclean ] && /usr/lib/php/sessionclean\n0 61 * * * root /bin/true\n",
+		"php.dpkg-old":     "this is not a crontab\n",
+		"logrotate~":       "this is not a crontab\n",
+		".placeholder":     "",
+		"broken_no_user":   "@hourly /usr/bin/ping\n",
+		"e2scrub_all-cron": "30 3 * * 0 root test -e /run/systemd/system || /sbin/e2scrub_all\n",
+	}
+
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "nested"), 0o755))
+
+	parsed, err := New(WithSystemCrontab()).ParseCrontabDir(dir)
+	assert.Nil(t, err)
+
+	var names []string
+	for _, file := range parsed {
+		names = append(names, filepath.Base(file.Path))
+	}
+
+	assert.Equal(t, []string{"broken_no_user", "e2scrub_all-cron", "logrotate", "php"}, names)
+
+	var crontabErr *CrontabError
+
+	assert.True(t, errors.As(parsed[0].Err, &crontabErr))
+	assert.Equal(t, 1, crontabErr.Errors[0].Line)
+	assert.ErrorIs(t, crontabErr.Errors[0], ErrInvalidFormat)
+
+	assert.Nil(t, parsed[1].Err)
+	assert.Equal(t, "root", parsed[1].Crontab.Entries[0].Schedule.User())
+
+	assert.Nil(t, parsed[2].Err)
+	assert.Equal(t, map[string]string{"MAILTO": "ops"}, parsed[2].Crontab.Entries[0].Env)
+	assert.Equal(t, "/usr/sbin/logrotate /etc/logrotate.conf", parsed[2].Crontab.Entries[0].Schedule.Command())
+
+	assert.True(t, errors.As(parsed[3].Err, &crontabErr))
+	assert.Equal(t, 2, crontabErr.Errors[0].Line)
+	assert.Len(t, parsed[3].Crontab.Entries, 1)
+
+	_, err = New().ParseCrontabDir(filepath.Join(dir, "missing"))
+	assert.NotNil(t, err)
+}
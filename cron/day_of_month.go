@@ -0,0 +1,25 @@
+package cron
+
+type dayOfMonth struct {
+	min int
+	max int
+}
+
+func newDayOfMonth() *dayOfMonth {
+	return &dayOfMonth{
+		min: 1,
+		max: 31,
+	}
+}
+
+// Expand accepts the Quartz forms L, L-n, nW and LW next to plain values,
+// they are returned as is and resolved once the month is known.
+func (d *dayOfMonth) Expand(field string) ([]string, error) {
+	return expandWithDaySpecs(field, d, func(plain string) ([]string, error) {
+		return expand(plain, d.min, d.max)
+	})
+}
+
+func (d *dayOfMonth) bounds() (int, int) {
+	return d.min, d.max
+}
@@ -0,0 +1,85 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type dayOfWeek struct {
+	min int
+	max int
+
+	numToEng map[int]string
+	engToNum map[string]int
+}
+
+func newDayOfWeek() *dayOfWeek {
+	numToEng := map[int]string{
+		0: "SUN",
+		1: "MON",
+		2: "TUE",
+		3: "WED",
+		4: "THU",
+		5: "FRI",
+		6: "SAT",
+	}
+
+	engToNum := make(map[string]int, len(numToEng))
+	for num, eng := range numToEng {
+		engToNum[eng] = num
+	}
+
+	return &dayOfWeek{
+		min:      0,
+		max:      7,
+		numToEng: numToEng,
+		engToNum: engToNum,
+	}
+}
+
+// Expand accepts weekday names (SUN-SAT, case-insensitive) anywhere a number
+// is allowed, e.g. "mon-fri" or "1,WED". The Quartz forms nL and n#k are
+// returned in numeric form (e.g. "FRIL" as "5L") and resolved once the month
+// is known.
+func (d *dayOfWeek) Expand(field string) ([]string, error) {
+	return expandWithDaySpecs(field, d, d.expandPlain)
+}
+
+func (d *dayOfWeek) expandPlain(field string) ([]string, error) {
+	result, err := expand(replaceAliases(field, d.engToNum), d.min, d.max)
+	if err != nil {
+		return nil, err
+	}
+
+	// values count from Sunday at min, a value of min+7 (such as "7" in
+	// Vixie cron) is another name for Sunday
+	var newResult []string
+
+	seen := make(map[int]bool, len(result))
+
+	for _, each := range result {
+		num, err := strconv.Atoi(each)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value: %s", each)
+		}
+
+		weekday := (num - d.min) % 7
+		if seen[weekday] {
+			continue
+		}
+
+		seen[weekday] = true
+		newResult = append(newResult, strconv.Itoa(weekday))
+	}
+
+	return newResult, nil
+}
+
+// bounds covers every weekday once, leaving out the second name of Sunday.
+func (d *dayOfWeek) bounds() (int, int) {
+	return d.min, d.min + 6
+}
+
+func (d *dayOfWeek) aliases() map[string]int {
+	return d.engToNum
+}
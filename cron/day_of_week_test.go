@@ -1,4 +1,4 @@
-package parser
+package cron
 
 import (
 	"errors"
@@ -39,6 +39,14 @@ func TestDayOfWeekExpand(t *testing.T) {
 			expOut: []string{"0", "2"},
 			expErr: nil,
 		},
+		{
+			msg:    "Test with Sunday as 7",
+			field:  "7",
+			min:    0,
+			max:    6,
+			expOut: []string{"0"},
+			expErr: nil,
+		},
 		{
 			msg:    "Test with range values",
 			field:  "0-7",
@@ -47,6 +55,62 @@ func TestDayOfWeekExpand(t *testing.T) {
 			expOut: []string{"0", "1", "2", "3", "4", "5", "6"},
 			expErr: nil,
 		},
+		{
+			msg:    "Test with single name",
+			field:  "MON",
+			min:    0,
+			max:    6,
+			expOut: []string{"1"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with lower case range of names",
+			field:  "mon-fri",
+			min:    0,
+			max:    6,
+			expOut: []string{"1", "2", "3", "4", "5"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with list of names",
+			field:  "SAT,SUN",
+			min:    0,
+			max:    6,
+			expOut: []string{"6", "0"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with range of names and step",
+			field:  "MON-FRI/2",
+			min:    0,
+			max:    6,
+			expOut: []string{"1", "3", "5"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with names mixed with numbers",
+			field:  "1,WED",
+			min:    0,
+			max:    6,
+			expOut: []string{"1", "3"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with Sunday as name and as 7",
+			field:  "SUN,7",
+			min:    0,
+			max:    6,
+			expOut: []string{"0"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with invalid name",
+			field:  "MONDAY",
+			min:    0,
+			max:    6,
+			expOut: nil,
+			expErr: errors.New("invalid value: MONDAY"),
+		},
 		{
 			msg:    "Test with invalid value",
 			field:  "8",
@@ -0,0 +1,234 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type daySpecKind int
+
+const (
+	lastDayOfMonth     daySpecKind = iota // L, or L-3 for three days before the last day
+	nearestWeekday                        // 15W, the weekday nearest to the 15th
+	lastWeekdayOfMonth                    // LW, the last Monday to Friday of the month
+	lastDayOfWeek                         // 5L, the last Friday of the month
+	nthDayOfWeek                          // 1#2, the second Monday of the month
+)
+
+// special returns the dialect extension a kind of spec needs.
+func (k daySpecKind) special() Special {
+	switch k {
+	case nearestWeekday:
+		return SpecialWeekday
+	case lastWeekdayOfMonth:
+		return SpecialLast | SpecialWeekday
+	case nthDayOfWeek:
+		return SpecialNth
+	default:
+		return SpecialLast
+	}
+}
+
+// syntax names a kind of spec in error messages.
+func (k daySpecKind) syntax() string {
+	switch k {
+	case nearestWeekday:
+		return "W"
+	case lastWeekdayOfMonth:
+		return "LW"
+	case nthDayOfWeek:
+		return "#"
+	default:
+		return "L"
+	}
+}
+
+// daySpec is a Quartz style day whose date depends on the month it is
+// evaluated in, so it cannot be expanded into a fixed list of values.
+type daySpec struct {
+	kind    daySpecKind
+	day     int // day of month for nearestWeekday, offset from the end for lastDayOfMonth
+	weekday int
+	nth     int
+}
+
+func (d daySpec) String() string {
+	switch d.kind {
+	case lastDayOfMonth:
+		if d.day == 0 {
+			return "L"
+		}
+
+		return fmt.Sprintf("L-%d", d.day)
+	case nearestWeekday:
+		return fmt.Sprintf("%dW", d.day)
+	case lastWeekdayOfMonth:
+		return "LW"
+	case lastDayOfWeek:
+		return fmt.Sprintf("%dL", d.weekday)
+	case nthDayOfWeek:
+		return fmt.Sprintf("%d#%d", d.weekday, d.nth)
+	default:
+		return "?"
+	}
+}
+
+// matches reports whether t falls on the day described by the spec.
+func (d daySpec) matches(t time.Time) bool {
+	lastDay := daysIn(t.Year(), t.Month())
+
+	switch d.kind {
+	case lastDayOfMonth:
+		return t.Day() == lastDay-d.day
+	case nearestWeekday:
+		if d.day > lastDay {
+			return false
+		}
+
+		return t.Day() == nearestWeekdayTo(t.Year(), t.Month(), d.day, lastDay)
+	case lastWeekdayOfMonth:
+		return t.Day() == nearestWeekdayTo(t.Year(), t.Month(), lastDay, lastDay)
+	case lastDayOfWeek:
+		return int(t.Weekday()) == d.weekday && t.Day()+7 > lastDay
+	case nthDayOfWeek:
+		return int(t.Weekday()) == d.weekday && (t.Day()-1)/7+1 == d.nth
+	default:
+		return false
+	}
+}
+
+// daySpecField is implemented by the day fields, which accept calendar
+// dependent values next to plain numbers.
+type daySpecField interface {
+	daySpec(value string) (daySpec, bool, error)
+}
+
+// canonicalDayFields parse the calendar dependent values returned by the
+// Expand method of the day fields, whatever the numbering of their dialect.
+var canonicalDayFields = map[Field]daySpecField{
+	DayOfMonth: newDayOfMonth(),
+	DayOfWeek:  newDayOfWeek(),
+}
+
+// daySpec parses the day of month forms L, L-n, nW and LW. It reports false
+// if value is not one of them.
+func (d *dayOfMonth) daySpec(value string) (daySpec, bool, error) {
+	upper := strings.ToUpper(value)
+
+	switch {
+	case upper == "L":
+		return daySpec{kind: lastDayOfMonth}, true, nil
+	case upper == "LW":
+		return daySpec{kind: lastWeekdayOfMonth}, true, nil
+	case strings.HasPrefix(upper, "L-"):
+		offset, err := strconv.Atoi(upper[2:])
+		if err != nil || offset < 0 || offset >= d.max {
+			return daySpec{}, false, fmt.Errorf("invalid value: %s", value)
+		}
+
+		return daySpec{kind: lastDayOfMonth, day: offset}, true, nil
+	case strings.HasSuffix(upper, "W"):
+		day, err := strconv.Atoi(upper[:len(upper)-1])
+		if err != nil || day < d.min || day > d.max {
+			return daySpec{}, false, fmt.Errorf("invalid value: %s", value)
+		}
+
+		return daySpec{kind: nearestWeekday, day: day}, true, nil
+	}
+
+	return daySpec{}, false, nil
+}
+
+// daySpec parses the day of week forms nL and n#k, where n is a weekday
+// number or name. It reports false if value is not one of them.
+func (d *dayOfWeek) daySpec(value string) (daySpec, bool, error) {
+	if before, after, found := strings.Cut(value, "#"); found {
+		weekday, err := d.weekday(before)
+		nth, nthErr := strconv.Atoi(after)
+		if err != nil || nthErr != nil || nth < 1 || nth > 5 {
+			return daySpec{}, false, fmt.Errorf("invalid value: %s", value)
+		}
+
+		return daySpec{kind: nthDayOfWeek, weekday: weekday, nth: nth}, true, nil
+	}
+
+	if len(value) > 1 && strings.HasSuffix(strings.ToUpper(value), "L") {
+		weekday, err := d.weekday(value[:len(value)-1])
+		if err != nil {
+			return daySpec{}, false, fmt.Errorf("invalid value: %s", value)
+		}
+
+		return daySpec{kind: lastDayOfWeek, weekday: weekday}, true, nil
+	}
+
+	return daySpec{}, false, nil
+}
+
+// weekday parses a single weekday number or name into the numbering of
+// Values, 0 (Sunday) to 6.
+func (d *dayOfWeek) weekday(value string) (int, error) {
+	num, err := strconv.Atoi(replaceAliases(value, d.engToNum))
+	if err != nil || num < d.min || num > d.max {
+		return 0, fmt.Errorf("invalid value: %s", value)
+	}
+
+	return (num - d.min) % 7, nil
+}
+
+// expandWithDaySpecs expands the plain values of a day field and appends the
+// canonical form of its calendar dependent values, e.g. "1,15,L" becomes
+// ["1" "15" "L"].
+func expandWithDaySpecs(field string, parser daySpecField, expandPlain func(string) ([]string, error)) ([]string, error) {
+	var plain, specs []string
+
+	for _, value := range strings.Split(field, ",") {
+		spec, ok, err := parser.daySpec(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			specs = append(specs, spec.String())
+		} else {
+			plain = append(plain, value)
+		}
+	}
+
+	if len(plain) == 0 {
+		return specs, nil
+	}
+
+	result, err := expandPlain(strings.Join(plain, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(result, specs...), nil
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekdayTo returns the Monday to Friday closest to day without
+// leaving the month, as Quartz does for nW.
+func nearestWeekdayTo(year int, month time.Month, day, lastDay int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+
+		return day + 1
+	default:
+		return day
+	}
+}
@@ -0,0 +1,142 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDayOfMonthExpandSpecials(t *testing.T) {
+	tests := []struct {
+		msg    string
+		field  string
+		expOut []string
+		expErr error
+	}{
+		{"Last day of month", "L", []string{"L"}, nil},
+		{"Last day of month in lower case", "l", []string{"L"}, nil},
+		{"Offset from last day of month", "L-3", []string{"L-3"}, nil},
+		{"Nearest weekday", "15W", []string{"15W"}, nil},
+		{"Last weekday of month", "LW", []string{"LW"}, nil},
+		{"Specials mixed with values", "1,15W,L", []string{"1", "15W", "L"}, nil},
+		{"Nearest weekday out of range", "32W", nil, errors.New("invalid value: 32W")},
+		{"Nearest weekday without day", "W", nil, errors.New("invalid value: W")},
+		{"Offset from last day out of range", "L-31", nil, errors.New("invalid value: L-31")},
+		{"Last day of week is not a day of month", "5L", nil, errors.New("invalid value: 5L")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			actualOut, actualErr := newDayOfMonth().Expand(test.field)
+
+			if actualErr != nil {
+				assert.ErrorContains(t, actualErr, test.expErr.Error())
+			} else {
+				assert.Nil(t, test.expErr)
+				assert.Equal(t, test.expOut, actualOut)
+			}
+		})
+	}
+}
+
+func TestDayOfWeekExpandSpecials(t *testing.T) {
+	tests := []struct {
+		msg    string
+		field  string
+		expOut []string
+		expErr error
+	}{
+		{"Last Friday of month", "5L", []string{"5L"}, nil},
+		{"Last Friday of month by name", "FRIL", []string{"5L"}, nil},
+		{"Last Sunday of month as 7", "7L", []string{"0L"}, nil},
+		{"Second Monday of month", "MON#2", []string{"1#2"}, nil},
+		{"Specials mixed with values", "SAT,1#1,SUNL", []string{"6", "1#1", "0L"}, nil},
+		{"Nth weekday out of range", "MON#6", nil, errors.New("invalid value: MON#6")},
+		{"Nth weekday without weekday", "#2", nil, errors.New("invalid value: #2")},
+		{"Unknown weekday name", "FOOL", nil, errors.New("invalid value: FOOL")},
+		{"Last day of month is not a day of week", "L", nil, errors.New("invalid value: L")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			actualOut, actualErr := newDayOfWeek().Expand(test.field)
+
+			if actualErr != nil {
+				assert.ErrorContains(t, actualErr, test.expErr.Error())
+			} else {
+				assert.Nil(t, test.expErr)
+				assert.Equal(t, test.expOut, actualOut)
+			}
+		})
+	}
+}
+
+func TestDaySpecMatches(t *testing.T) {
+	tests := []struct {
+		msg      string
+		spec     daySpec
+		expDates []string
+	}{
+		{"Last day of a leap February", daySpec{kind: lastDayOfMonth}, []string{"2028-02-29"}},
+		{"Two days before the last day", daySpec{kind: lastDayOfMonth, day: 2}, []string{"2028-02-27"}},
+		{"15th is a Tuesday", daySpec{kind: nearestWeekday, day: 15}, []string{"2028-02-15"}},
+		{"5th is a Saturday", daySpec{kind: nearestWeekday, day: 5}, []string{"2028-02-04"}},
+		{"6th is a Sunday", daySpec{kind: nearestWeekday, day: 6}, []string{"2028-02-07"}},
+		{"30th does not exist", daySpec{kind: nearestWeekday, day: 30}, nil},
+		{"Last weekday when the month ends on a Tuesday", daySpec{kind: lastWeekdayOfMonth}, []string{"2028-02-29"}},
+		{"Last Friday", daySpec{kind: lastDayOfWeek, weekday: 5}, []string{"2028-02-25"}},
+		{"Second Monday", daySpec{kind: nthDayOfWeek, weekday: 1, nth: 2}, []string{"2028-02-14"}},
+		{"Fifth Tuesday", daySpec{kind: nthDayOfWeek, weekday: 2, nth: 5}, []string{"2028-02-29"}},
+		{"Fifth Monday does not exist", daySpec{kind: nthDayOfWeek, weekday: 1, nth: 5}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			var actual []string
+
+			for day := 1; day <= daysIn(2028, time.February); day++ {
+				date := time.Date(2028, time.February, day, 0, 0, 0, 0, time.UTC)
+				if test.spec.matches(date) {
+					actual = append(actual, date.Format("2006-01-02"))
+				}
+			}
+
+			assert.Equal(t, test.expDates, actual)
+		})
+	}
+}
+
+func TestNearestWeekdayStaysInMonth(t *testing.T) {
+	// 1 April 2028 is a Saturday, 30 April 2028 is a Sunday
+	assert.Equal(t, 3, nearestWeekdayTo(2028, time.April, 1, 30))
+	assert.Equal(t, 28, nearestWeekdayTo(2028, time.April, 30, 30))
+}
+
+func TestScheduleNextWithDaySpecs(t *testing.T) {
+	tests := []struct {
+		msg     string
+		input   string
+		expNext []string
+	}{
+		{"Last day of month", "0 0 L * * /command", []string{"2026-10-31T00:00:00Z", "2026-11-30T00:00:00Z", "2026-12-31T00:00:00Z"}},
+		{"Last weekday of month", "0 0 LW * * /command", []string{"2026-10-30T00:00:00Z", "2026-11-30T00:00:00Z", "2026-12-31T00:00:00Z"}},
+		{"Nearest weekday to the 1st", "0 0 1W * * /command", []string{"2026-11-02T00:00:00Z", "2026-12-01T00:00:00Z", "2027-01-01T00:00:00Z"}},
+		{"Last Friday of month", "0 0 * * 5L /command", []string{"2026-10-30T00:00:00Z", "2026-11-27T00:00:00Z", "2026-12-25T00:00:00Z"}},
+		{"Second Monday of month", "0 0 * * MON#2 /command", []string{"2026-11-09T00:00:00Z", "2026-12-14T00:00:00Z", "2027-01-11T00:00:00Z"}},
+		{"Last day of February", "0 0 L 2 * /command", []string{"2027-02-28T00:00:00Z", "2028-02-29T00:00:00Z", "2029-02-28T00:00:00Z"}},
+	}
+
+	cron := New()
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.Parse(test.input)
+			assert.Nil(t, err)
+
+			actual := schedule.NextN(mustParseTime(t, "2026-10-17T00:00:00Z"), 3)
+
+			assert.Equal(t, test.expNext, formatTimes(actual))
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Special is a set of the syntax extensions a dialect accepts on top of
+// values, lists, ranges and steps.
+type Special int
+
+const (
+	// SpecialLast accepts L and L-n in the day of month field and nL in the
+	// day of week field.
+	SpecialLast Special = 1 << iota
+	// SpecialWeekday accepts nW in the day of month field, and LW together
+	// with SpecialLast.
+	SpecialWeekday
+	// SpecialNth accepts n#k in the day of week field.
+	SpecialNth
+	// SpecialHash accepts the Jenkins style H tokens.
+	SpecialHash
+	// SpecialRandom accepts the OpenBSD style ~ tokens.
+	SpecialRandom
+	// SpecialMacros accepts @yearly, @annually, @monthly, @weekly, @daily,
+	// @midnight and @hourly.
+	SpecialMacros
+	// SpecialReboot accepts @reboot.
+	SpecialReboot
+	// SpecialEvery accepts @every intervals.
+	SpecialEvery
+	// SpecialQuestionMark accepts ? ("no specific value") in the day
+	// fields, see Schedule.NoSpecificValue.
+	SpecialQuestionMark
+	// SpecialEventBridge accepts the Amazon EventBridge forms "cron(fields)"
+	// and "rate(value unit)".
+	SpecialEventBridge
+)
+
+// FieldSpec describes one field of the expressions of a dialect.
+type FieldSpec struct {
+	Field Field
+	// Min and Max are the bounds of the values written in the field. The
+	// day of week field counts from Sunday at Min, and a Max of Min+7 is
+	// Sunday again, as 7 is in Vixie cron.
+	Min, Max int
+	// Aliases maps upper case names such as "JAN" or "MON" to the number
+	// they stand for.
+	Aliases map[string]int
+	// Optional marks a trailing field that may be left out, as the year of
+	// Quartz expressions.
+	Optional bool
+}
+
+// Dialect describes the cron syntax of a platform: which fields an
+// expression has, their bounds and names, and the extensions it accepts.
+// Whatever the dialect, Schedule.Values numbers the days of the week from
+// 0 (Sunday) to 6.
+type Dialect struct {
+	// Name identifies the dialect, e.g. for the --dialect flag.
+	Name string
+	// Fields is the layout of an expression, in order.
+	Fields []FieldSpec
+	// Specials lists the extensions the dialect accepts.
+	Specials Special
+	// DayMatching is how the dialect combines the two day fields.
+	DayMatching DayMatching
+	// RequireQuestionMark demands ? in exactly one of the day fields.
+	RequireQuestionMark bool
+	// Location is the time zone schedules are evaluated in unless they have
+	// a CRON_TZ= prefix. If nil they are evaluated in the location of the
+	// time passed to Next.
+	Location *time.Location
+
+	// yearByCount lets ParseExpression take one field more than the layout
+	// as a year, as it did before dialects existed.
+	yearByCount bool
+}
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+
+	// weekdayNames number the weekdays from Sunday at 0, quartzWeekdayNames
+	// from Sunday at 1.
+	weekdayNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+	quartzWeekdayNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+)
+
+var (
+	secondSpec     = FieldSpec{Field: Second, Min: 0, Max: 59}
+	minuteSpec     = FieldSpec{Field: Minute, Min: 0, Max: 59}
+	hourSpec       = FieldSpec{Field: Hour, Min: 0, Max: 23}
+	dayOfMonthSpec = FieldSpec{Field: DayOfMonth, Min: 1, Max: 31}
+	monthSpec      = FieldSpec{Field: Month, Min: 1, Max: 12, Aliases: monthNames}
+	dayOfWeekSpec  = FieldSpec{Field: DayOfWeek, Min: 0, Max: 7, Aliases: weekdayNames}
+)
+
+var (
+	// Standard is the dialect of parsers created without WithDialect. It
+	// has the five Vixie cron fields and accepts every extension of this
+	// package.
+	Standard = Dialect{
+		Name:        "standard",
+		Fields:      []FieldSpec{minuteSpec, hourSpec, dayOfMonthSpec, monthSpec, dayOfWeekSpec},
+		Specials:    SpecialLast | SpecialWeekday | SpecialNth | SpecialHash | SpecialRandom | SpecialMacros | SpecialReboot | SpecialEvery | SpecialQuestionMark,
+		DayMatching: DayMatchVixie,
+		yearByCount: true,
+	}
+
+	// Vixie is the classic five field syntax of Vixie cron and cronie, with
+	// Sunday as 0 or 7 and the @ macros including @reboot.
+	Vixie = Dialect{
+		Name:        "vixie",
+		Fields:      []FieldSpec{minuteSpec, hourSpec, dayOfMonthSpec, monthSpec, dayOfWeekSpec},
+		Specials:    SpecialMacros | SpecialReboot,
+		DayMatching: DayMatchVixie,
+	}
+
+	// Quartz is the syntax of the Quartz scheduler: a leading seconds field,
+	// weekdays numbered from 1 (Sunday) to 7, an optional year (1970-2099),
+	// the L, W and # day specifiers and ? in exactly one of the day fields.
+	Quartz = Dialect{
+		Name: "quartz",
+		Fields: []FieldSpec{
+			secondSpec, minuteSpec, hourSpec, dayOfMonthSpec, monthSpec,
+			{Field: DayOfWeek, Min: 1, Max: 7, Aliases: quartzWeekdayNames},
+			{Field: Year, Min: 1970, Max: 2099, Optional: true},
+		},
+		Specials:            SpecialLast | SpecialWeekday | SpecialNth | SpecialQuestionMark,
+		DayMatching:         DayMatchAnd,
+		RequireQuestionMark: true,
+	}
+
+	// Spring is the syntax of Spring's CronExpression: six fields starting
+	// with seconds, Sunday as 0 or 7, the L, W and # day specifiers, ? in
+	// the day fields and the @ macros except @reboot.
+	Spring = Dialect{
+		Name:        "spring",
+		Fields:      []FieldSpec{secondSpec, minuteSpec, hourSpec, dayOfMonthSpec, monthSpec, dayOfWeekSpec},
+		Specials:    SpecialLast | SpecialWeekday | SpecialNth | SpecialMacros | SpecialQuestionMark,
+		DayMatching: DayMatchAnd,
+	}
+
+	// Kubernetes is the syntax of the schedule of a Kubernetes CronJob: five
+	// fields with Sunday as 0 only, ? in the day fields and the @ macros
+	// except @reboot.
+	Kubernetes = Dialect{
+		Name: "kubernetes",
+		Fields: []FieldSpec{
+			minuteSpec, hourSpec, dayOfMonthSpec, monthSpec,
+			{Field: DayOfWeek, Min: 0, Max: 6, Aliases: weekdayNames},
+		},
+		Specials:    SpecialMacros | SpecialQuestionMark,
+		DayMatching: DayMatchVixie,
+	}
+
+	// AWS is the syntax of Amazon EventBridge: "cron(fields)" with six
+	// fields ending with a year (1970-2199), weekdays numbered from 1
+	// (Sunday) to 7, the L, W and # day specifiers and ? in exactly one of
+	// the day fields, as well as "rate(value unit)". Schedules are in UTC.
+	AWS = Dialect{
+		Name: "aws",
+		Fields: []FieldSpec{
+			minuteSpec, hourSpec, dayOfMonthSpec, monthSpec,
+			{Field: DayOfWeek, Min: 1, Max: 7, Aliases: quartzWeekdayNames},
+			{Field: Year, Min: 1970, Max: 2199},
+		},
+		Specials:            SpecialLast | SpecialWeekday | SpecialNth | SpecialQuestionMark | SpecialEventBridge,
+		DayMatching:         DayMatchAnd,
+		RequireQuestionMark: true,
+		Location:            time.UTC,
+	}
+)
+
+// dialects are the built-in dialects by name.
+var dialects = map[string]Dialect{
+	Standard.Name:   Standard,
+	Vixie.Name:      Vixie,
+	Quartz.Name:     Quartz,
+	Spring.Name:     Spring,
+	Kubernetes.Name: Kubernetes,
+	AWS.Name:        AWS,
+}
+
+// LookupDialect returns the built-in dialect with the given name, ignoring
+// case.
+func LookupDialect(name string) (Dialect, error) {
+	dialect, ok := dialects[strings.ToLower(name)]
+	if !ok {
+		return Dialect{}, fmt.Errorf("unknown dialect %q, expected one of %s", name, strings.Join(DialectNames(), ", "))
+	}
+
+	return dialect, nil
+}
+
+// DialectNames returns the names of the built-in dialects in alphabetical
+// order.
+func DialectNames() []string {
+	names := make([]string, 0, len(dialects))
+	for name := range dialects {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// allows reports whether the dialect accepts every extension in s.
+func (d Dialect) allows(s Special) bool {
+	return d.Specials&s == s
+}
+
+// has reports whether field is part of the layout of the dialect.
+func (d Dialect) has(field Field) bool {
+	for _, spec := range d.Fields {
+		if spec.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unsupported reports syntax the dialect does not accept.
+func (d Dialect) unsupported(syntax string) error {
+	return fmt.Errorf("%s is not supported by the %s dialect", syntax, d.Name)
+}
+
+// newField returns the field parser for spec.
+func newField(spec FieldSpec) CronField {
+	switch spec.Field {
+	case Second:
+		return &second{min: spec.Min, max: spec.Max}
+	case Minute:
+		return &minute{min: spec.Min, max: spec.Max}
+	case Hour:
+		return &hour{min: spec.Min, max: spec.Max}
+	case DayOfMonth:
+		return &dayOfMonth{min: spec.Min, max: spec.Max}
+	case Month:
+		return &month{min: spec.Min, max: spec.Max, numToEng: invertAliases(spec.Aliases), engToNum: spec.Aliases}
+	case DayOfWeek:
+		return &dayOfWeek{min: spec.Min, max: spec.Max, numToEng: invertAliases(spec.Aliases), engToNum: spec.Aliases}
+	default:
+		return &year{min: spec.Min, max: spec.Max}
+	}
+}
+
+func invertAliases(aliases map[string]int) map[int]string {
+	result := make(map[int]string, len(aliases))
+	for name, num := range aliases {
+		result[num] = name
+	}
+
+	return result
+}
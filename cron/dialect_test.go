@@ -0,0 +1,150 @@
+package cron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseWithDialect(t *testing.T) {
+	tests := []struct {
+		msg          string
+		dialect      Dialect
+		input        string
+		expFields    []Field
+		expDayOfWeek []int
+		expYear      []int
+	}{
+		{
+			msg:          "vixie sunday as 7",
+			dialect:      Vixie,
+			input:        "0 9 * * 5-7",
+			expFields:    []Field{Minute, Hour, DayOfMonth, Month, DayOfWeek},
+			expDayOfWeek: []int{0, 5, 6},
+		},
+		{
+			msg:          "quartz weekdays count from sunday at 1",
+			dialect:      Quartz,
+			input:        "0 0 12 ? * 2-6",
+			expFields:    []Field{Second, Minute, Hour, DayOfMonth, Month, DayOfWeek},
+			expDayOfWeek: []int{1, 2, 3, 4, 5},
+		},
+		{
+			msg:          "quartz weekday names",
+			dialect:      Quartz,
+			input:        "0 0 12 ? * MON-FRI",
+			expFields:    []Field{Second, Minute, Hour, DayOfMonth, Month, DayOfWeek},
+			expDayOfWeek: []int{1, 2, 3, 4, 5},
+		},
+		{
+			msg:          "quartz optional year",
+			dialect:      Quartz,
+			input:        "0 0 12 ? * SAT 2027-2028",
+			expFields:    []Field{Second, Minute, Hour, DayOfMonth, Month, DayOfWeek, Year},
+			expDayOfWeek: []int{6},
+			expYear:      []int{2027, 2028},
+		},
+		{
+			msg:          "spring",
+			dialect:      Spring,
+			input:        "*/10 0 9 * * 7",
+			expFields:    []Field{Second, Minute, Hour, DayOfMonth, Month, DayOfWeek},
+			expDayOfWeek: []int{0},
+		},
+		{
+			msg:          "kubernetes",
+			dialect:      Kubernetes,
+			input:        "0 9 * * 0,6",
+			expFields:    []Field{Minute, Hour, DayOfMonth, Month, DayOfWeek},
+			expDayOfWeek: []int{0, 6},
+		},
+		{
+			msg:          "aws",
+			dialect:      AWS,
+			input:        "0 12 ? * 1 2030",
+			expFields:    []Field{Minute, Hour, DayOfMonth, Month, DayOfWeek, Year},
+			expDayOfWeek: []int{0},
+			expYear:      []int{2030},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := New(WithDialect(test.dialect)).ParseExpression(test.input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expFields, schedule.Fields())
+			assert.Equal(t, test.expDayOfWeek, schedule.Values(DayOfWeek))
+			assert.Equal(t, test.expYear, schedule.Values(Year))
+			assert.Equal(t, test.dialect.DayMatching, schedule.DayMatching())
+		})
+	}
+}
+
+func TestParseWithDialectOptionalField(t *testing.T) {
+	cron := New(WithDialect(Quartz))
+
+	schedule, err := cron.Parse("0 0 12 ? * MON 2027 /usr/bin/report")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2027}, schedule.Values(Year))
+	assert.Equal(t, "/usr/bin/report", schedule.Command())
+
+	schedule, err = cron.Parse("0 0 12 ? * MON /usr/bin/report 2027")
+	assert.Nil(t, err)
+	assert.False(t, schedule.hasField(Year))
+	assert.Equal(t, "/usr/bin/report 2027", schedule.Command())
+}
+
+func TestParseWithDialectErrors(t *testing.T) {
+	tests := []struct {
+		msg      string
+		dialect  Dialect
+		input    string
+		expError string
+	}{
+		{"vixie last day", Vixie, "0 0 L * *", "error in parsing day of month. err: L is not supported by the vixie dialect"},
+		{"vixie hash", Vixie, "H 0 * * *", "error in parsing minute. err: H is not supported by the vixie dialect"},
+		{"vixie random", Vixie, "0~30 0 * * *", "error in parsing minute. err: ~ is not supported by the vixie dialect"},
+		{"vixie every", Vixie, "@every 1h", "invalid cron expression: @every is not supported by the vixie dialect"},
+		{"kubernetes nth weekday", Kubernetes, "0 0 * * MON#2", "error in parsing day of week. err: # is not supported by the kubernetes dialect"},
+		{"kubernetes nearest weekday", Kubernetes, "0 0 15W * *", "error in parsing day of month. err: W is not supported by the kubernetes dialect"},
+		{"kubernetes sunday as 7", Kubernetes, "0 0 * * 7", "error in parsing day of week. err: invalid value: 7"},
+		{"kubernetes reboot", Kubernetes, "@reboot", "invalid cron expression: @reboot is not supported by the kubernetes dialect"},
+		{"quartz weekday 0", Quartz, "0 0 12 ? * 0", "error in parsing day of week. err: invalid value: 0"},
+		{"quartz macro", Quartz, "@daily", "invalid cron expression: @daily is not supported by the quartz dialect"},
+		{"quartz year bound", Quartz, "0 0 12 ? * MON 2100", "error in parsing year. err: invalid value: 2100"},
+		{"aws missing year", AWS, "0 12 * * 1", "invalid cron expression: expected 6 fields, got 5"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			_, err := New(WithDialect(test.dialect)).ParseExpression(test.input)
+
+			assert.ErrorContains(t, err, test.expError)
+		})
+	}
+}
+
+func TestDialectWithOptions(t *testing.T) {
+	schedule, err := New(WithDialect(Kubernetes), WithSeconds(), WithYear()).ParseExpression("30 0 9 * * 1 2027")
+	assert.Nil(t, err)
+	assert.Equal(t, []Field{Second, Minute, Hour, DayOfMonth, Month, DayOfWeek, Year}, schedule.Fields())
+
+	schedule, err = New(WithDialect(Spring), WithDayMatching(DayMatchVixie)).ParseExpression("0 0 12 1 * MON")
+	assert.Nil(t, err)
+	assert.True(t, schedule.MatchesEitherDay())
+
+	// Kubernetes has no year field, so one field too many is an error
+	_, err = New(WithDialect(Kubernetes)).ParseExpression("0 9 * * 1 2027")
+	assert.ErrorIs(t, err, ErrInvalidExpression)
+}
+
+func TestLookupDialect(t *testing.T) {
+	dialect, err := LookupDialect("Quartz")
+	assert.Nil(t, err)
+	assert.Equal(t, "quartz", dialect.Name)
+
+	_, err = LookupDialect("jenkins")
+	assert.EqualError(t, err, `unknown dialect "jenkins", expected one of aws, kubernetes, quartz, spring, standard, vixie`)
+
+	assert.Equal(t, []string{"aws", "kubernetes", "quartz", "spring", "standard", "vixie"}, DialectNames())
+}
@@ -0,0 +1,26 @@
+// Package cron parses cron expressions into schedules.
+//
+// A Parser turns an expression such as "*/15 0 1,15 * 1-5 /usr/bin/find"
+// into a Schedule that lists the values allowed for every time field
+// together with the command to run:
+//
+//	schedule, err := cron.New().Parse("*/15 0 1,15 * 1-5 /usr/bin/find")
+//	if err != nil {
+//		// handle the error
+//	}
+//	minutes := schedule.Values(cron.Minute) // [0 15 30 45]
+//
+// Errors about a single field are reported as *ParseError, which carries the
+// offending field and value. Errors about the overall shape of the input wrap
+// ErrInvalidFormat or ErrInvalidExpression and can be checked with errors.Is.
+//
+// # Compatibility
+//
+// The exported API of this package follows semantic versioning. Within a
+// major version, exported identifiers are not removed or renamed, function
+// signatures do not change, and a valid expression keeps parsing to the same
+// schedule. New fields, options and syntax may be added; values of the Field
+// type may be appended to but existing ones keep their meaning. Error
+// messages are meant for humans and may change, use errors.Is and errors.As
+// to inspect errors.
+package cron
@@ -0,0 +1,38 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidFormat is returned when the input is not a cron expression
+	// followed by a command.
+	ErrInvalidFormat = errors.New("incorrect input format")
+
+	// ErrInvalidExpression is returned when a cron expression has the wrong
+	// number of fields.
+	ErrInvalidExpression = errors.New("invalid cron expression")
+
+	// ErrNotRepresentable is returned when a schedule cannot be converted
+	// into another format without changing when it fires.
+	ErrNotRepresentable = errors.New("schedule cannot be represented exactly")
+)
+
+// ParseError reports a field of a cron expression that could not be expanded.
+type ParseError struct {
+	// Field is the field that failed to parse.
+	Field Field
+	// Value is the text of the field as written in the expression.
+	Value string
+	// Err is the underlying reason.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("error in parsing %s. err: %s", e.Field, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
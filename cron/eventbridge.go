@@ -0,0 +1,84 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateUnits are the units of an EventBridge rate expression, singular for a
+// value of 1 and plural otherwise.
+var rateUnits = map[string]time.Duration{
+	"minute":  time.Minute,
+	"minutes": time.Minute,
+	"hour":    time.Hour,
+	"hours":   time.Hour,
+	"day":     24 * time.Hour,
+	"days":    24 * time.Hour,
+}
+
+// parseEventBridge parses the Amazon EventBridge forms "cron(fields)" and
+// "rate(value unit)" at the start of input and returns the schedule with the
+// text after the closing parenthesis. It reports false if input starts with
+// neither form or the dialect does not accept them.
+func (p *Parser) parseEventBridge(input string) (*Schedule, string, bool, error) {
+	if !p.dialect.allows(SpecialEventBridge) {
+		return nil, "", false, nil
+	}
+
+	trimmed := strings.TrimLeft(input, " \t")
+
+	name, args, found := strings.Cut(trimmed, "(")
+	if !found || (name != "cron" && name != "rate") {
+		return nil, "", false, nil
+	}
+
+	args, rest, found := strings.Cut(args, ")")
+	if !found {
+		return nil, "", true, fmt.Errorf("%w: missing ) in %s", ErrInvalidExpression, trimmed)
+	}
+
+	rest = strings.TrimLeft(rest, " \t")
+
+	if name == "rate" {
+		schedule, err := p.parseRate(args)
+
+		return schedule, rest, true, err
+	}
+
+	schedule, err := p.parse(p.fields, strings.TrimSpace(args), "")
+
+	return schedule, rest, true, err
+}
+
+// parseRate parses the "value unit" of a rate expression, e.g. "5 minutes",
+// into a schedule of KindInterval anchored at the parser's interval start.
+func (p *Parser) parseRate(args string) (*Schedule, error) {
+	parts := strings.Fields(args)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: invalid rate(%s), expected a value and a unit", ErrInvalidExpression, args)
+	}
+
+	value, err := strconv.Atoi(parts[0])
+	if err != nil || value <= 0 {
+		return nil, fmt.Errorf("%w: invalid rate(%s), the value must be a positive whole number", ErrInvalidExpression, args)
+	}
+
+	unit, ok := rateUnits[parts[1]]
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid rate(%s), the unit must be minute(s), hour(s) or day(s)", ErrInvalidExpression, args)
+	}
+
+	// like EventBridge, insist on "1 hour" and "2 hours"
+	if plural := strings.HasSuffix(parts[1], "s"); plural != (value > 1) {
+		return nil, fmt.Errorf("%w: invalid rate(%s), use a singular unit for 1 and a plural one otherwise", ErrInvalidExpression, args)
+	}
+
+	return &Schedule{
+		kind:        KindInterval,
+		interval:    time.Duration(value) * unit,
+		anchor:      p.intervalStart,
+		dayMatching: p.dayMatching,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package cron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseEventBridgeCron(t *testing.T) {
+	cron := New(WithDialect(AWS))
+
+	tests := []struct {
+		msg           string
+		input         string
+		expDayOfMonth []int
+		expDayOfWeek  []int
+		expSpecials   []string
+		expYear       []int
+	}{
+		{
+			msg:           "every day at noon",
+			input:         "cron(0 12 * * ? *)",
+			expDayOfMonth: expandRange(1, 31),
+			expYear:       expandRange(1970, 2199),
+		},
+		{
+			msg:          "weekdays numbered from sunday at 1",
+			input:        "cron(15 10 ? * 2-6 2027)",
+			expDayOfWeek: []int{1, 2, 3, 4, 5},
+			expYear:      []int{2027},
+		},
+		{
+			msg:         "last friday of the month",
+			input:       "cron(0 18 ? * 6L 2027-2029)",
+			expSpecials: []string{"5L"},
+			expYear:     []int{2027, 2028, 2029},
+		},
+		{
+			msg:          "without the cron wrapper",
+			input:        "0/10 * ? * MON-FRI *",
+			expDayOfWeek: []int{1, 2, 3, 4, 5},
+			expYear:      expandRange(1970, 2199),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.ParseExpression(test.input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expDayOfMonth, schedule.Values(DayOfMonth))
+			assert.Equal(t, test.expDayOfWeek, schedule.Values(DayOfWeek))
+			assert.Equal(t, test.expSpecials, schedule.Specials(DayOfWeek))
+			assert.Equal(t, test.expYear, schedule.Values(Year))
+			assert.Equal(t, time.UTC, schedule.Location())
+		})
+	}
+}
+
+func TestParseEventBridgeRate(t *testing.T) {
+	cron := New(WithDialect(AWS))
+
+	tests := []struct {
+		input       string
+		expInterval time.Duration
+	}{
+		{"rate(1 minute)", time.Minute},
+		{"rate(5 minutes)", 5 * time.Minute},
+		{"rate(1 hour)", time.Hour},
+		{"rate(12 hours)", 12 * time.Hour},
+		{"rate(7 days)", 7 * 24 * time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			schedule, err := cron.ParseExpression(test.input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, KindInterval, schedule.Kind())
+			assert.Equal(t, test.expInterval, schedule.Interval())
+			assert.Equal(t, time.UTC, schedule.Location())
+		})
+	}
+
+	schedule, err := cron.Parse("rate(5 minutes) /usr/bin/poll")
+	assert.Nil(t, err)
+	assert.Equal(t, "/usr/bin/poll", schedule.Command())
+}
+
+func TestParseEventBridgeErrors(t *testing.T) {
+	tests := []struct {
+		msg      string
+		input    string
+		expError string
+	}{
+		{"no question mark", "cron(0 12 * * * *)", "invalid cron expression: the aws dialect requires ? in exactly one of the day of month and day of week fields"},
+		{"two question marks", "cron(0 12 ? * ? *)", "invalid cron expression: the aws dialect requires ? in exactly one of the day of month and day of week fields"},
+		{"question mark outside the day fields", "cron(? 12 ? * 1 *)", "error in expanding cron expression: ? 12 ? * 1 *, err: error in parsing minute. err: ? is only allowed in the day of month and day of week fields"},
+		{"weekday 0", "cron(0 12 ? * 0 *)", "error in expanding cron expression: 0 12 ? * 0 *, err: error in parsing day of week. err: invalid value: 0"},
+		{"missing year", "cron(0 12 * * ?)", "invalid cron expression: expected 6 fields, got 5"},
+		{"unclosed", "cron(0 12 * * ? *", "invalid cron expression: missing ) in cron(0 12 * * ? *"},
+		{"trailing text", "cron(0 12 * * ? *) daily", "invalid cron expression: unexpected text after the expression: daily"},
+		{"singular unit for many", "rate(5 minute)", "invalid cron expression: invalid rate(5 minute), use a singular unit for 1 and a plural one otherwise"},
+		{"plural unit for one", "rate(1 hours)", "invalid cron expression: invalid rate(1 hours), use a singular unit for 1 and a plural one otherwise"},
+		{"unknown unit", "rate(30 seconds)", "invalid cron expression: invalid rate(30 seconds), the unit must be minute(s), hour(s) or day(s)"},
+		{"zero", "rate(0 minutes)", "invalid cron expression: invalid rate(0 minutes), the value must be a positive whole number"},
+		{"missing unit", "rate(5)", "invalid cron expression: invalid rate(5), expected a value and a unit"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			_, err := New(WithDialect(AWS)).ParseExpression(test.input)
+
+			assert.EqualError(t, err, test.expError)
+		})
+	}
+
+	// other dialects do not know the wrapper
+	_, err := New().ParseExpression("rate(5 minutes)")
+	assert.ErrorIs(t, err, ErrInvalidExpression)
+}
+
+func TestEventBridgeNextInUTC(t *testing.T) {
+	schedule, err := New(WithDialect(AWS)).ParseExpression("cron(0 12 ? * MON-FRI *)")
+	assert.Nil(t, err)
+
+	// Saturday 17 October 2026, 09:00 in UTC+02:00
+	from := mustParseTime(t, "2026-10-17T09:00:00+02:00")
+
+	assert.Equal(t, []string{
+		"2026-10-19T14:00:00+02:00",
+		"2026-10-20T14:00:00+02:00",
+	}, formatTimes(schedule.NextN(from, 2)))
+}
+
+func expandRange(min, max int) []int {
+	var result []int
+	for i := min; i <= max; i++ {
+		result = append(result, i)
+	}
+
+	return result
+}
@@ -0,0 +1,74 @@
+package cron_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cronparser/cron"
+)
+
+func Example() {
+	schedule, err := cron.New().Parse("*/15 0 1,15 * 1-5 /usr/bin/find")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, field := range schedule.Fields() {
+		fmt.Printf("%-14s%v\n", field, schedule.Values(field))
+	}
+	fmt.Printf("%-14s%s\n", "command", schedule.Command())
+
+	// Output:
+	// minute        [0 15 30 45]
+	// hour          [0]
+	// day of month  [1 15]
+	// month         [1 2 3 4 5 6 7 8 9 10 11 12]
+	// day of week   [1 2 3 4 5]
+	// command       /usr/bin/find
+}
+
+func ExampleParser_Parse() {
+	schedule, err := cron.New().Parse("0 9 * JAN,JUL MON-FRI /usr/bin/report")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(schedule.Values(cron.Month))
+	fmt.Println(schedule.Values(cron.DayOfWeek))
+
+	// Output:
+	// [1 7]
+	// [1 2 3 4 5]
+}
+
+func ExampleParseError() {
+	_, err := cron.New().Parse("60 0 * * * /usr/bin/find")
+
+	var parseErr *cron.ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Printf("field %q has invalid value %q\n", parseErr.Field, parseErr.Value)
+	}
+
+	// Output:
+	// field "minute" has invalid value "60"
+}
+
+func ExampleSchedule_Next() {
+	schedule, err := cron.New().Parse("0 9 * * MON-FRI /usr/bin/report")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	from := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC) // a Saturday
+
+	fmt.Println(schedule.Next(from))
+	fmt.Println(schedule.Prev(from))
+
+	// Output:
+	// 2026-10-19 09:00:00 +0000 UTC
+	// 2026-10-16 09:00:00 +0000 UTC
+}
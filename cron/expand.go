@@ -1,11 +1,14 @@
-package parser
+package cron
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+var aliasPattern = regexp.MustCompile(`[A-Za-z]+`)
+
 func expand(field string, min, max int) ([]string, error) {
 	if field == "*" {
 		result := expandAllValues(min, max)
@@ -97,9 +100,13 @@ func expandSteps(value string, min, max int) ([]string, error) {
 			return nil, fmt.Errorf("invalid range field: %s", value)
 		}
 
+		if lowerRange < min || upperRange > max {
+			return nil, fmt.Errorf("invalid range values: %s", value)
+		}
+
 	} else { // scenario: 5/3 ; if minute, then 5,8,11,14,17,....
 		lowerRange, err = strconv.Atoi(parts[0])
-		if err != nil {
+		if err != nil || lowerRange < min || lowerRange > max {
 			return nil, fmt.Errorf("invalid base value: %s", value)
 		}
 
@@ -175,3 +182,16 @@ func verifyMinMax(min, max int) error {
 
 	return nil
 }
+
+// replaceAliases rewrites every name in field (e.g. JAN or mon) with its
+// numeric value from aliases, ignoring case. Unknown names are left as they
+// are so that expand reports them as invalid values.
+func replaceAliases(field string, aliases map[string]int) string {
+	return aliasPattern.ReplaceAllStringFunc(field, func(name string) string {
+		if num, ok := aliases[strings.ToUpper(name)]; ok {
+			return strconv.Itoa(num)
+		}
+
+		return name
+	})
+}
@@ -1,4 +1,4 @@
-package parser
+package cron
 
 import (
 	"errors"
@@ -113,6 +113,9 @@ func TestExpandSteps(t *testing.T) {
 		{"Invalid range format: 5-/2", "5-/2", 0, 59, nil, errors.New("invalid range field: 5-/2")},
 		{"Invalid range values: lowerBound > upperBound", "5-3/2", 0, 59, nil, errors.New("error in generating steps, err: upper bound cannot be less than lower bound")},
 		{"Invalid, lowerBound > upperBound", "5/3", 10, 5, nil, errors.New("upper bound cannot be less than lower bound")},
+		{"Invalid range values: upperBound out of bounds", "1-31/2", 0, 23, nil, errors.New("invalid range values: 1-31/2")},
+		{"Invalid range values: lowerBound out of bounds", "0-31/2", 1, 31, nil, errors.New("invalid range values: 0-31/2")},
+		{"Invalid base value: out of bounds", "0/5", 1, 31, nil, errors.New("invalid base value: 0/5")},
 		{"Invalid range: step as *)", "*/*", 0, 59, nil, errors.New("invalid interval value: */*")},
 	}
 
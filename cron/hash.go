@@ -0,0 +1,109 @@
+package cron
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// boundedField is implemented by the fields of this package, it gives the
+// range of values tokens such as H may resolve to.
+type boundedField interface {
+	bounds() (min, max int)
+}
+
+// maxHashedDayOfMonth keeps a bare H in the day of month field on days that
+// exist in every month, as Jenkins does.
+const maxHashedDayOfMonth = 28
+
+// resolveHash replaces the Jenkins style hash tokens H, H(a-b), H/n and
+// H(a-b)/n in a field with values derived from seed, so that every seed gets
+// a stable spread of values. Elements without H are returned unchanged.
+func resolveHash(value, seed string, field Field, min, max int) (string, error) {
+	elements := strings.Split(value, ",")
+
+	for i, element := range elements {
+		if !strings.HasPrefix(strings.ToUpper(element), "H") {
+			continue
+		}
+
+		if seed == "" {
+			return "", fmt.Errorf("hash value %s requires a hash seed", element)
+		}
+
+		resolved, err := resolveHashElement(element, seed, field, min, max)
+		if err != nil {
+			return "", err
+		}
+
+		elements[i] = resolved
+	}
+
+	return strings.Join(elements, ","), nil
+}
+
+// hasHash reports whether any element of a field is a hash token.
+func hasHash(value string) bool {
+	for _, element := range strings.Split(value, ",") {
+		if strings.HasPrefix(strings.ToUpper(element), "H") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resolveHashElement(element, seed string, field Field, min, max int) (string, error) {
+	rangePart, stepPart, hasStep := strings.Cut(element[1:], "/")
+
+	lower, upper := min, max
+	if field == DayOfMonth && upper > maxHashedDayOfMonth {
+		upper = maxHashedDayOfMonth
+	}
+
+	if rangePart != "" {
+		if !strings.HasPrefix(rangePart, "(") || !strings.HasSuffix(rangePart, ")") {
+			return "", fmt.Errorf("invalid hash value: %s", element)
+		}
+
+		start, end, found := strings.Cut(rangePart[1:len(rangePart)-1], "-")
+
+		var err1, err2 error
+		lower, err1 = strconv.Atoi(start)
+		upper, err2 = strconv.Atoi(end)
+		if !found || err1 != nil || err2 != nil || lower < min || upper > max || lower > upper {
+			return "", fmt.Errorf("invalid hash range: %s", element)
+		}
+	}
+
+	hash := hashOf(seed, field)
+
+	if !hasStep {
+		return strconv.Itoa(lower + int(hash%uint32(upper-lower+1))), nil
+	}
+
+	step, err := strconv.Atoi(stepPart)
+	if err != nil || step <= 0 {
+		return "", fmt.Errorf("invalid interval value: %s", element)
+	}
+
+	// H/n fires every n starting at a hashed offset within the first step
+	window := step
+	if span := upper - lower + 1; span < window {
+		window = span
+	}
+
+	offset := int(hash % uint32(window))
+
+	return fmt.Sprintf("%d-%d/%d", lower+offset, upper, step), nil
+}
+
+// hashOf mixes the field into the seed so that e.g. "H H * * *" does not put
+// the minute and the hour on the same number.
+func hashOf(seed string, field Field) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(seed + "/" + field.String()))
+
+	return h.Sum32()
+}
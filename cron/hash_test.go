@@ -0,0 +1,71 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResolveHash(t *testing.T) {
+	tests := []struct {
+		msg    string
+		value  string
+		field  Field
+		min    int
+		max    int
+		expOut string
+		expErr error
+	}{
+		{"Without hash token", "*/15", Minute, 0, 59, "*/15", nil},
+		{"Bare hash", "H", Minute, 0, 59, "12", nil},
+		{"Hash within a range", "H(0-7)", Hour, 0, 23, "6", nil},
+		{"Hash with step", "H/15", Minute, 0, 59, "12-59/15", nil},
+		{"Hash within a range with step", "H(10-40)/10", Minute, 0, 59, "12-40/10", nil},
+		{"Step larger than the range", "H(0-3)/10", Minute, 0, 59, "0-3/10", nil},
+		{"Hash in a list", "H,30", Minute, 0, 59, "12,30", nil},
+		{"Bare hash in day of month", "H", DayOfMonth, 1, 31, "6", nil},
+		{"Invalid range", "H(7-0)", Hour, 0, 23, "", errors.New("invalid hash range: H(7-0)")},
+		{"Range out of bounds", "H(0-24)", Hour, 0, 23, "", errors.New("invalid hash range: H(0-24)")},
+		{"Invalid step", "H/0", Minute, 0, 59, "", errors.New("invalid interval value: H/0")},
+		{"Invalid syntax", "H5", Minute, 0, 59, "", errors.New("invalid hash value: H5")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			actualOut, actualErr := resolveHash(test.value, "nightly-backup", test.field, test.min, test.max)
+
+			if test.expErr != nil {
+				assert.EqualError(t, actualErr, test.expErr.Error())
+			} else {
+				assert.Nil(t, actualErr)
+				assert.Equal(t, test.expOut, actualOut)
+			}
+		})
+	}
+}
+
+func TestParseWithHashSeed(t *testing.T) {
+	first, err := New(WithHashSeed("nightly-backup")).Parse("H H(0-7) * * * /usr/bin/backup")
+	assert.Nil(t, err)
+
+	again, err := New(WithHashSeed("nightly-backup")).Parse("H H(0-7) * * * /usr/bin/backup")
+	assert.Nil(t, err)
+
+	other, err := New(WithHashSeed("weekly-report")).Parse("H H(0-7) * * * /usr/bin/backup")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []int{12}, first.Values(Minute))
+	assert.Equal(t, []int{6}, first.Values(Hour))
+	assert.Equal(t, first.Values(Minute), again.Values(Minute))
+	assert.Equal(t, first.Values(Hour), again.Values(Hour))
+	assert.NotEqual(t, first.Values(Minute), other.Values(Minute))
+
+	schedule, err := New(WithHashSeed("nightly-backup")).ParseExpression("H/15 * * * H")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{12, 27, 42, 57}, schedule.Values(Minute))
+	assert.Len(t, schedule.Values(DayOfWeek), 1)
+	assert.LessOrEqual(t, schedule.Values(DayOfWeek)[0], 6)
+
+	_, err = New().ParseExpression("H * * * *")
+	assert.ErrorContains(t, err, "error in parsing minute. err: hash value H requires a hash seed")
+}
@@ -1,4 +1,4 @@
-package parser
+package cron
 
 type hour struct {
 	min int
@@ -19,3 +19,7 @@ func (h *hour) Validate() error {
 func (h *hour) Expand(field string) ([]string, error) {
 	return expand(field, h.min, h.max)
 }
+
+func (h *hour) bounds() (int, int) {
+	return h.min, h.max
+}
@@ -0,0 +1,97 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// macros maps the predefined schedules to the five fields they stand for.
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * SUN",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+const (
+	// rebootMacro runs a command once when cron starts, it has no fields.
+	rebootMacro = "@reboot"
+	// everyMacro runs a command at a fixed interval, e.g. "@every 1h30m".
+	everyMacro = "@every"
+)
+
+func isMacro(input string) bool {
+	return strings.HasPrefix(strings.TrimSpace(input), "@")
+}
+
+// parseMacro expands a predefined schedule such as "@daily" into the fields
+// of the parser, with the seconds and year fields (if any) set to "0" and
+// "*". "@reboot" becomes a schedule of KindReboot.
+func (p *Parser) parseMacro(macro, command string) (*Schedule, error) {
+	name := strings.ToLower(macro)
+
+	if name == rebootMacro && !p.dialect.allows(SpecialReboot) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpression, p.dialect.unsupported(macro))
+	}
+
+	if name == rebootMacro {
+		return &Schedule{
+			kind:        KindReboot,
+			macro:       name,
+			command:     command,
+			dayMatching: p.dayMatching,
+		}, nil
+	}
+
+	expr, ok := macros[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown macro %s", ErrInvalidExpression, macro)
+	}
+
+	if !p.dialect.allows(SpecialMacros) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpression, p.dialect.unsupported(macro))
+	}
+
+	if p.fields[0] == Second {
+		expr = "0 " + expr
+	}
+
+	if p.fields[len(p.fields)-1] == Year {
+		expr += " *"
+	}
+
+	schedule, err := p.parse(p.fields, expr, command)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.macro = name
+
+	return schedule, nil
+}
+
+// parseEvery parses the Go duration of an "@every" schedule into a schedule
+// of KindInterval anchored at the parser's interval start.
+func (p *Parser) parseEvery(every, command string) (*Schedule, error) {
+	if !p.dialect.allows(SpecialEvery) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpression, p.dialect.unsupported(everyMacro))
+	}
+
+	interval, err := time.ParseDuration(every)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("%w: invalid interval %s", ErrInvalidExpression, every)
+	}
+
+	return &Schedule{
+		kind:        KindInterval,
+		macro:       everyMacro,
+		interval:    interval,
+		anchor:      p.intervalStart,
+		command:     command,
+		dayMatching: p.dayMatching,
+	}, nil
+}
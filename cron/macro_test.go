@@ -0,0 +1,159 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseMacro(t *testing.T) {
+	tests := []struct {
+		msg   string
+		input string
+		equal string
+	}{
+		{"yearly", "@yearly /usr/bin/backup", "0 0 1 1 * /usr/bin/backup"},
+		{"annually", "@annually /usr/bin/backup", "0 0 1 1 * /usr/bin/backup"},
+		{"monthly", "@monthly /usr/bin/backup", "0 0 1 * * /usr/bin/backup"},
+		{"weekly", "@weekly /usr/bin/backup", "0 0 * * 0 /usr/bin/backup"},
+		{"daily", "@daily /usr/bin/backup", "0 0 * * * /usr/bin/backup"},
+		{"midnight", "@midnight /usr/bin/backup", "0 0 * * * /usr/bin/backup"},
+		{"hourly", "@hourly /usr/bin/backup", "0 * * * * /usr/bin/backup"},
+		{"upper case", "@DAILY /usr/bin/backup", "0 0 * * * /usr/bin/backup"},
+		{"extra spaces before the command", "@daily   /usr/bin/backup", "0 0 * * * /usr/bin/backup"},
+	}
+
+	cron := New()
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			actual, err := cron.Parse(test.input)
+			assert.Nil(t, err)
+
+			expected, err := cron.Parse(test.equal)
+			assert.Nil(t, err)
+
+			assert.Equal(t, KindCalendar, actual.Kind())
+			assert.Equal(t, expected.Fields(), actual.Fields())
+			for _, field := range expected.Fields() {
+				assert.Equal(t, expected.Values(field), actual.Values(field))
+			}
+			assert.Equal(t, expected.MatchesEitherDay(), actual.MatchesEitherDay())
+			assert.Equal(t, "/usr/bin/backup", actual.Command())
+		})
+	}
+}
+
+func TestParseMacroWithSecondsAndYear(t *testing.T) {
+	schedule, err := New(WithSeconds(), WithYear()).ParseExpression("@hourly")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "@hourly", schedule.Macro())
+	assert.Equal(t, []int{0}, schedule.Values(Second))
+	assert.Equal(t, []int{0}, schedule.Values(Minute))
+	assert.Len(t, schedule.Values(Hour), 24)
+	assert.Len(t, schedule.Values(Year), 2199-1970+1)
+}
+
+func TestParseReboot(t *testing.T) {
+	schedule, err := New().Parse("@reboot /usr/bin/startup --now")
+	assert.Nil(t, err)
+
+	assert.Equal(t, KindReboot, schedule.Kind())
+	assert.Equal(t, "@reboot", schedule.Macro())
+	assert.Empty(t, schedule.Fields())
+	assert.Equal(t, "/usr/bin/startup --now", schedule.Command())
+
+	from := time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+	assert.True(t, schedule.Next(from).IsZero())
+	assert.True(t, schedule.Prev(from).IsZero())
+	assert.Empty(t, schedule.NextN(from, 5))
+}
+
+func TestParseMacroErrors(t *testing.T) {
+	tests := []struct {
+		msg    string
+		input  string
+		expErr error
+	}{
+		{"unknown macro", "@fortnightly /usr/bin/backup", errors.New("invalid cron expression: unknown macro @fortnightly")},
+		{"macro without command", "@daily", ErrInvalidFormat},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			_, err := New().Parse(test.input)
+
+			assert.EqualError(t, err, test.expErr.Error())
+		})
+	}
+
+	_, err := New().ParseExpression("@daily 0")
+	assert.ErrorIs(t, err, ErrInvalidExpression)
+}
+
+func TestParseEvery(t *testing.T) {
+	tests := []struct {
+		msg         string
+		input       string
+		expInterval time.Duration
+		expCommand  string
+	}{
+		{"minutes", "@every 90m /usr/bin/sync", 90 * time.Minute, "/usr/bin/sync"},
+		{"hours and minutes", "@every 1h30m /usr/bin/sync --all", 90 * time.Minute, "/usr/bin/sync --all"},
+		{"seconds", "@every  45s   /usr/bin/sync", 45 * time.Second, "/usr/bin/sync"},
+	}
+
+	cron := New()
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.Parse(test.input)
+			assert.Nil(t, err)
+
+			assert.Equal(t, KindInterval, schedule.Kind())
+			assert.Equal(t, "@every", schedule.Macro())
+			assert.Equal(t, test.expInterval, schedule.Interval())
+			assert.Equal(t, time.Unix(0, 0).UTC(), schedule.Anchor())
+			assert.Empty(t, schedule.Fields())
+			assert.Equal(t, test.expCommand, schedule.Command())
+		})
+	}
+
+	for _, input := range []string{"@every 0s /command", "@every -5m /command", "@every often /command"} {
+		_, err := cron.Parse(input)
+		assert.ErrorIs(t, err, ErrInvalidExpression, input)
+	}
+
+	_, err := cron.Parse("@every 5m")
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
+
+func TestScheduleEvery(t *testing.T) {
+	tests := []struct {
+		msg     string
+		start   time.Time
+		from    string
+		expNext string
+		expPrev string
+	}{
+		{"anchored at the epoch", time.Unix(0, 0), "2026-10-17T10:15:00Z", "2026-10-17T10:30:00Z", "2026-10-17T09:00:00Z"},
+		{"exactly on a fire time", time.Unix(0, 0), "2026-10-17T09:00:00Z", "2026-10-17T10:30:00Z", "2026-10-17T07:30:00Z"},
+		{"anchored at a start time", mustParseTime(t, "2026-10-17T10:00:00Z"), "2026-10-17T12:00:00Z", "2026-10-17T13:00:00Z", "2026-10-17T11:30:00Z"},
+		{"before the start time", mustParseTime(t, "2026-10-17T10:00:00Z"), "2026-10-17T08:00:00Z", "2026-10-17T10:00:00Z", "0001-01-01T00:00:00Z"},
+		{"keeps the input location", time.Unix(0, 0), "2026-10-17T10:15:00+02:00", "2026-10-17T11:00:00+02:00", "2026-10-17T09:30:00+02:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := New(WithIntervalStart(test.start)).ParseExpression("@every 1h30m")
+			assert.Nil(t, err)
+
+			from := mustParseTime(t, test.from)
+
+			assert.Equal(t, test.expNext, schedule.Next(from).Format(time.RFC3339))
+			assert.Equal(t, test.expPrev, schedule.Prev(from).Format(time.RFC3339))
+		})
+	}
+}
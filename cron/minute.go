@@ -1,4 +1,4 @@
-package parser
+package cron
 
 type minute struct {
 	min int
@@ -25,3 +25,7 @@ func (m *minute) Expand(field string) ([]string, error) {
 func (m *minute) Print() {
 
 }
+
+func (m *minute) bounds() (int, int) {
+	return m.min, m.max
+}
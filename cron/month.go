@@ -0,0 +1,56 @@
+package cron
+
+type month struct {
+	min int
+	max int
+
+	numToEng map[int]string
+	engToNum map[string]int
+}
+
+func newMonth() *month {
+	numToEng := map[int]string{
+		1:  "JAN",
+		2:  "FEB",
+		3:  "MAR",
+		4:  "APR",
+		5:  "MAY",
+		6:  "JUN",
+		7:  "JUL",
+		8:  "AUG",
+		9:  "SEP",
+		10: "OCT",
+		11: "NOV",
+		12: "DEC",
+	}
+
+	engToNum := make(map[string]int, len(numToEng))
+	for num, eng := range numToEng {
+		engToNum[eng] = num
+	}
+
+	return &month{
+		min:      1,
+		max:      12,
+		numToEng: numToEng,
+		engToNum: engToNum,
+	}
+}
+
+func (m *month) Validate() error {
+	return nil
+}
+
+// Expand accepts month names (JAN-DEC, case-insensitive) anywhere a number
+// is allowed, e.g. "feb-apr" or "MAR-NOV/2".
+func (m *month) Expand(field string) ([]string, error) {
+	return expand(replaceAliases(field, m.engToNum), m.min, m.max)
+}
+
+func (m *month) bounds() (int, int) {
+	return m.min, m.max
+}
+
+func (m *month) aliases() map[string]int {
+	return m.engToNum
+}
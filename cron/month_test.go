@@ -0,0 +1,67 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMonthExpand(t *testing.T) {
+	tests := []struct {
+		msg    string
+		field  string
+		expOut []string
+		expErr error
+	}{
+		{
+			msg:    "Test with single name",
+			field:  "JAN",
+			expOut: []string{"1"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with lower case range",
+			field:  "feb-apr",
+			expOut: []string{"2", "3", "4"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with list of names",
+			field:  "JAN,JUL",
+			expOut: []string{"1", "7"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with range and step",
+			field:  "MAR-NOV/2",
+			expOut: []string{"3", "5", "7", "9", "11"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with names mixed with numbers",
+			field:  "1,Jun-8,dec",
+			expOut: []string{"1", "6", "7", "8", "12"},
+			expErr: nil,
+		},
+		{
+			msg:    "Test with invalid name",
+			field:  "FOO",
+			expOut: nil,
+			expErr: errors.New("invalid value: FOO"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			m := newMonth()
+			actualOut, actualErr := m.Expand(test.field)
+
+			if actualErr != nil {
+				assert.ErrorContains(t, actualErr, test.expErr.Error())
+			} else {
+				assert.Nil(t, test.expErr)
+				assert.Equal(t, test.expOut, actualOut)
+			}
+		})
+	}
+}
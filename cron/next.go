@@ -0,0 +1,279 @@
+package cron
+
+import "time"
+
+// maxSearchYears bounds how far Next and Prev look for a matching time, so
+// that schedules which can never fire (e.g. 30 February) terminate.
+const maxSearchYears = 400
+
+// Next returns the first time strictly after t at which the schedule fires.
+// The calculation is done in the schedule's Location, or in t's location if
+// the schedule has none, and the result is in t's location. Next returns
+// the zero Time if the schedule never fires, which is always the case for
+// KindReboot schedules.
+//
+// When daylight saving time ends and a local hour repeats, schedules that
+// fire every hour fire in both passes of it, while those with fixed hours
+// only fire in the first, as Vixie cron does.
+func (s *Schedule) Next(t time.Time) time.Time {
+	switch s.kind {
+	case KindInterval:
+		return s.nextInterval(t)
+	case KindReboot:
+		return time.Time{}
+	}
+
+	orig, loc := t.Location(), s.locationFor(t)
+	resolution := s.resolution()
+
+	next := t.In(loc).Truncate(resolution).Add(resolution)
+	limit := next.Year() + maxSearchYears
+
+	for next.Year() <= limit {
+		if !s.yearMatches(next.Year()) {
+			year, ok := s.nextYear(next.Year())
+			if !ok {
+				return time.Time{}
+			}
+
+			next = time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !contains(s.values[Month], int(next.Month())) {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		// hours, minutes and seconds are stepped in absolute time, so that
+		// daylight saving transitions cannot send the search backwards
+		if !contains(s.values[Hour], next.Hour()) || s.skipsRepeatedHour(next) {
+			next = next.Add(time.Hour - sinceHour(next))
+			continue
+		}
+
+		if !contains(s.values[Minute], next.Minute()) {
+			next = next.Add(time.Minute - sinceMinute(next))
+			continue
+		}
+
+		if !contains(s.seconds(), next.Second()) {
+			next = next.Add(time.Second)
+			continue
+		}
+
+		return next.In(orig)
+	}
+
+	return time.Time{}
+}
+
+// Prev returns the last time strictly before t at which the schedule fired.
+// The calculation is done in the schedule's Location, or in t's location if
+// the schedule has none, and the result is in t's location. Prev returns
+// the zero Time if the schedule never fired, which is always the case for
+// KindReboot schedules. A repeated local hour is treated as in Next.
+func (s *Schedule) Prev(t time.Time) time.Time {
+	switch s.kind {
+	case KindInterval:
+		return s.prevInterval(t)
+	case KindReboot:
+		return time.Time{}
+	}
+
+	orig, loc := t.Location(), s.locationFor(t)
+	resolution := s.resolution()
+
+	prev := t.In(loc).Truncate(resolution)
+	if !prev.Before(t) {
+		prev = prev.Add(-resolution)
+	}
+
+	limit := prev.Year() - maxSearchYears
+
+	for prev.Year() >= limit {
+		if !s.yearMatches(prev.Year()) {
+			year, ok := s.prevYear(prev.Year())
+			if !ok {
+				return time.Time{}
+			}
+
+			prev = time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc).Add(-resolution)
+			continue
+		}
+
+		if !contains(s.values[Month], int(prev.Month())) {
+			prev = time.Date(prev.Year(), prev.Month(), 1, 0, 0, 0, 0, loc).Add(-resolution)
+			continue
+		}
+
+		if !s.dayMatches(prev) {
+			prev = time.Date(prev.Year(), prev.Month(), prev.Day(), 0, 0, 0, 0, loc).Add(-resolution)
+			continue
+		}
+
+		if !contains(s.values[Hour], prev.Hour()) || s.skipsRepeatedHour(prev) {
+			prev = prev.Add(-sinceHour(prev) - resolution)
+			continue
+		}
+
+		if !contains(s.values[Minute], prev.Minute()) {
+			prev = prev.Add(-sinceMinute(prev) - resolution)
+			continue
+		}
+
+		if !contains(s.seconds(), prev.Second()) {
+			prev = prev.Add(-time.Second)
+			continue
+		}
+
+		return prev.In(orig)
+	}
+
+	return time.Time{}
+}
+
+// locationFor returns the location fire times are calculated in.
+func (s *Schedule) locationFor(t time.Time) *time.Location {
+	if s.location != nil {
+		return s.location
+	}
+
+	return t.Location()
+}
+
+// nextInterval returns the first fire time of an interval schedule after t,
+// the anchor itself if t is before it.
+func (s *Schedule) nextInterval(t time.Time) time.Time {
+	if t.Before(s.anchor) {
+		return s.anchor.In(t.Location())
+	}
+
+	steps := t.Sub(s.anchor)/s.interval + 1
+
+	return s.anchor.Add(steps * s.interval).In(t.Location())
+}
+
+// prevInterval returns the last fire time of an interval schedule before t,
+// the zero Time if t is not after the anchor.
+func (s *Schedule) prevInterval(t time.Time) time.Time {
+	if !t.After(s.anchor) {
+		return time.Time{}
+	}
+
+	steps := (t.Sub(s.anchor) - 1) / s.interval
+
+	return s.anchor.Add(steps * s.interval).In(t.Location())
+}
+
+// yearMatches reports whether year is allowed, which is any year for
+// schedules without a year field.
+func (s *Schedule) yearMatches(year int) bool {
+	return !s.hasField(Year) || contains(s.values[Year], year)
+}
+
+// nextYear returns the first allowed year after year, it reports false once
+// the last allowed year has passed.
+func (s *Schedule) nextYear(year int) (int, bool) {
+	for _, each := range s.values[Year] {
+		if each > year {
+			return each, true
+		}
+	}
+
+	return 0, false
+}
+
+// prevYear returns the last allowed year before year, it reports false if
+// there is none.
+func (s *Schedule) prevYear(year int) (int, bool) {
+	years := s.values[Year]
+	for i := len(years) - 1; i >= 0; i-- {
+		if years[i] < year {
+			return years[i], true
+		}
+	}
+
+	return 0, false
+}
+
+// resolution is the smallest step between two fire times of the schedule.
+func (s *Schedule) resolution() time.Duration {
+	if s.hasField(Second) {
+		return time.Second
+	}
+
+	return time.Minute
+}
+
+// seconds returns the seconds the schedule fires at, which is only the full
+// minute for schedules without a seconds field.
+func (s *Schedule) seconds() []int {
+	if s.hasField(Second) {
+		return s.values[Second]
+	}
+
+	return []int{0}
+}
+
+// skipsRepeatedHour reports whether t is in the second pass of a local hour
+// that repeats when daylight saving time ends, and the schedule only fires
+// at fixed hours.
+func (s *Schedule) skipsRepeatedHour(t time.Time) bool {
+	if len(s.values[Hour]) == 24 {
+		return false
+	}
+
+	earlier := t.Add(-time.Hour)
+
+	return earlier.Hour() == t.Hour() && earlier.Day() == t.Day()
+}
+
+// sinceHour returns how far t is past the start of its hour.
+func sinceHour(t time.Time) time.Duration {
+	return time.Duration(t.Minute())*time.Minute + sinceMinute(t)
+}
+
+// sinceMinute returns how far t is past the start of its minute.
+func sinceMinute(t time.Time) time.Duration {
+	return time.Duration(t.Second()) * time.Second
+}
+
+// dayMatches reports whether the day of t is allowed by the day of month
+// and day of week fields, combined as described by MatchesEitherDay. A ?
+// field allows every day.
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.NoSpecificValue(DayOfMonth) || contains(s.values[DayOfMonth], t.Day()) || specsMatch(s.specs[DayOfMonth], t)
+	dayOfWeek := s.NoSpecificValue(DayOfWeek) || contains(s.values[DayOfWeek], int(t.Weekday())) || specsMatch(s.specs[DayOfWeek], t)
+
+	if s.MatchesEitherDay() {
+		return dayOfMonth || dayOfWeek
+	}
+
+	return dayOfMonth && dayOfWeek
+}
+
+func specsMatch(specs []daySpec, t time.Time) bool {
+	for _, spec := range specs {
+		if spec.matches(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(values []int, value int) bool {
+	for _, each := range values {
+		if each == value {
+			return true
+		}
+	}
+
+	return false
+}
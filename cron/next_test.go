@@ -0,0 +1,245 @@
+package cron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		t.Fatalf("invalid time %s: %s", value, err)
+	}
+
+	return parsed
+}
+
+func TestScheduleNext(t *testing.T) {
+	tests := []struct {
+		msg     string
+		input   string
+		from    string
+		expNext string
+	}{
+		{"every minute", "* * * * * /command", "2026-10-17T10:15:30Z", "2026-10-17T10:16:00Z"},
+		{"exactly on a fire time", "*/15 * * * * /command", "2026-10-17T10:15:00Z", "2026-10-17T10:30:00Z"},
+		{"rolls over to the next hour", "0,30 * * * * /command", "2026-10-17T10:45:00Z", "2026-10-17T11:00:00Z"},
+		{"rolls over to the next day", "0 9 * * * /command", "2026-10-17T10:00:00Z", "2026-10-18T09:00:00Z"},
+		{"rolls over to the next year", "0 0 1 1 * /command", "2026-10-17T10:00:00Z", "2027-01-01T00:00:00Z"},
+		{"skips short months", "0 0 31 * * /command", "2026-04-01T00:00:00Z", "2026-05-31T00:00:00Z"},
+		{"leap day", "0 0 29 2 * /command", "2026-10-17T00:00:00Z", "2028-02-29T00:00:00Z"},
+		{"weekday restriction", "0 8 * * MON /command", "2026-10-17T10:00:00Z", "2026-10-19T08:00:00Z"},
+		{"day of month or day of week", "0 0 13 * FRI /command", "2026-10-17T00:00:00Z", "2026-10-23T00:00:00Z"},
+		{"day of month or weekdays", "0 0 1 * 1-5 /command", "2026-10-17T00:00:00Z", "2026-10-19T00:00:00Z"},
+		{"wildcard day of week with step is combined with and", "0 0 1 * */2 /command", "2026-10-17T00:00:00Z", "2026-11-01T00:00:00Z"},
+		{"respects the input location", "0 9 * * * /command", "2026-10-17T12:00:00-04:00", "2026-10-18T09:00:00-04:00"},
+	}
+
+	cron := New()
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.Parse(test.input)
+			assert.Nil(t, err)
+
+			actual := schedule.Next(mustParseTime(t, test.from))
+
+			assert.Equal(t, test.expNext, actual.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestSchedulePrev(t *testing.T) {
+	tests := []struct {
+		msg     string
+		input   string
+		from    string
+		expPrev string
+	}{
+		{"every minute", "* * * * * /command", "2026-10-17T10:15:30Z", "2026-10-17T10:15:00Z"},
+		{"exactly on a fire time", "*/15 * * * * /command", "2026-10-17T10:15:00Z", "2026-10-17T10:00:00Z"},
+		{"rolls back to the previous day", "0 9 * * * /command", "2026-10-17T08:00:00Z", "2026-10-16T09:00:00Z"},
+		{"rolls back to the previous year", "30 23 31 12 * /command", "2026-10-17T10:00:00Z", "2025-12-31T23:30:00Z"},
+		{"skips short months", "0 0 31 * * /command", "2026-05-01T00:00:00Z", "2026-03-31T00:00:00Z"},
+		{"leap day", "0 0 29 2 * /command", "2026-10-17T00:00:00Z", "2024-02-29T00:00:00Z"},
+		{"respects the input location", "0 9 * * * /command", "2026-10-17T08:00:00-04:00", "2026-10-16T09:00:00-04:00"},
+	}
+
+	cron := New()
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.Parse(test.input)
+			assert.Nil(t, err)
+
+			actual := schedule.Prev(mustParseTime(t, test.from))
+
+			assert.Equal(t, test.expPrev, actual.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestScheduleDayMatching(t *testing.T) {
+	tests := []struct {
+		msg         string
+		input       string
+		dayMatching DayMatching
+		expEither   bool
+		expNext     string
+	}{
+		{"vixie with both day fields restricted", "0 0 13 * FRI /command", DayMatchVixie, true, "2026-10-23T00:00:00Z"},
+		{"vixie with day of week wildcard", "0 0 13 * * /command", DayMatchVixie, false, "2026-11-13T00:00:00Z"},
+		{"vixie with day of month wildcard", "0 0 * * FRI /command", DayMatchVixie, false, "2026-10-23T00:00:00Z"},
+		{"and with both day fields restricted", "0 0 13 * FRI /command", DayMatchAnd, false, "2026-11-13T00:00:00Z"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := New(WithDayMatching(test.dayMatching)).Parse(test.input)
+			assert.Nil(t, err)
+
+			assert.Equal(t, test.dayMatching, schedule.DayMatching())
+			assert.Equal(t, test.expEither, schedule.MatchesEitherDay())
+
+			actual := schedule.Next(mustParseTime(t, "2026-10-17T00:00:00Z"))
+
+			assert.Equal(t, test.expNext, actual.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestScheduleWithSeconds(t *testing.T) {
+	tests := []struct {
+		msg     string
+		input   string
+		from    string
+		expNext string
+		expPrev string
+	}{
+		{"every second", "* * * * * *", "2026-10-17T10:15:30.5Z", "2026-10-17T10:15:31Z", "2026-10-17T10:15:30Z"},
+		{"every 20 seconds", "*/20 * * * * *", "2026-10-17T10:15:30Z", "2026-10-17T10:15:40Z", "2026-10-17T10:15:20Z"},
+		{"second rolls over to the next minute", "10 * * * * *", "2026-10-17T10:15:30Z", "2026-10-17T10:16:10Z", "2026-10-17T10:15:10Z"},
+		{"second rolls over to the next hour", "30 0 * * * *", "2026-10-17T10:15:30Z", "2026-10-17T11:00:30Z", "2026-10-17T10:00:30Z"},
+		{"second rolls over to the next day", "59 59 23 * * *", "2026-10-17T10:15:30Z", "2026-10-17T23:59:59Z", "2026-10-16T23:59:59Z"},
+	}
+
+	cron := New(WithSeconds())
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			from := mustParseTime(t, test.from)
+
+			assert.Equal(t, test.expNext, schedule.Next(from).Format(time.RFC3339))
+			assert.Equal(t, test.expPrev, schedule.Prev(from).Format(time.RFC3339))
+		})
+	}
+}
+
+func TestScheduleWithYear(t *testing.T) {
+	tests := []struct {
+		msg     string
+		input   string
+		from    string
+		expNext string
+		expPrev string
+	}{
+		{"jumps to the allowed year", "0 0 1 1 * 2028", "2026-10-17T00:00:00Z", "2028-01-01T00:00:00Z", "0001-01-01T00:00:00Z"},
+		{"inside the allowed year", "0 12 * * * 2026", "2026-10-17T00:00:00Z", "2026-10-17T12:00:00Z", "2026-10-16T12:00:00Z"},
+		{"last allowed year has passed", "0 0 1 1 * 2020-2025", "2026-10-17T00:00:00Z", "0001-01-01T00:00:00Z", "2025-01-01T00:00:00Z"},
+		{"between allowed years", "0 0 L 12 * 2020,2030", "2026-10-17T00:00:00Z", "2030-12-31T00:00:00Z", "2020-12-31T00:00:00Z"},
+		{"allowed year without a matching day", "0 0 29 2 * 2027", "2026-10-17T00:00:00Z", "0001-01-01T00:00:00Z", "0001-01-01T00:00:00Z"},
+	}
+
+	cron := New(WithYear())
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			from := mustParseTime(t, test.from)
+
+			assert.Equal(t, test.expNext, schedule.Next(from).Format(time.RFC3339))
+			assert.Equal(t, test.expPrev, schedule.Prev(from).Format(time.RFC3339))
+		})
+	}
+}
+
+func TestScheduleNextAcrossDaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %s", err)
+	}
+
+	schedule, err := New().Parse("30 * * * * /command")
+	assert.Nil(t, err)
+
+	// clocks go back from 02:00 EDT to 01:00 EST on 1 November 2026
+	from := time.Date(2026, time.November, 1, 0, 45, 0, 0, loc)
+
+	first := schedule.Next(from)
+	second := schedule.Next(first)
+	third := schedule.Next(second)
+
+	assert.Equal(t, "2026-11-01T01:30:00-04:00", first.Format(time.RFC3339))
+	assert.Equal(t, "2026-11-01T01:30:00-05:00", second.Format(time.RFC3339))
+	assert.Equal(t, "2026-11-01T02:30:00-05:00", third.Format(time.RFC3339))
+
+	assert.Equal(t, second, schedule.Prev(third))
+	assert.Equal(t, first, schedule.Prev(second))
+}
+
+func TestScheduleNextFixedHourAcrossDaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %s", err)
+	}
+
+	schedule, err := New().Parse("30 1 * * * /command")
+	assert.Nil(t, err)
+
+	// 01:00 to 02:00 repeats on 7 November 2027, the job only runs once
+	from := time.Date(2027, time.November, 7, 0, 0, 0, 0, loc)
+
+	first := schedule.Next(from)
+	second := schedule.Next(first)
+
+	assert.Equal(t, "2027-11-07T01:30:00-04:00", first.Format(time.RFC3339))
+	assert.Equal(t, "2027-11-08T01:30:00-05:00", second.Format(time.RFC3339))
+
+	assert.Equal(t, first, schedule.Prev(second))
+	assert.Equal(t, first, schedule.Prev(time.Date(2027, time.November, 7, 1, 45, 0, 0, loc).Add(time.Hour)))
+}
+
+func TestScheduleNextInScheduleTimeZone(t *testing.T) {
+	schedule, err := New().Parse("CRON_TZ=America/New_York 0 9 * * 1-5 /usr/bin/report")
+	if err != nil {
+		t.Skipf("time zone database not available: %s", err)
+	}
+
+	// Friday 16 October 2026, 14:00 in New York is 18:00 UTC
+	from := mustParseTime(t, "2026-10-16T18:00:00Z")
+
+	next := schedule.Next(from)
+	prev := schedule.Prev(from)
+
+	assert.Equal(t, "2026-10-19T13:00:00Z", next.Format(time.RFC3339))
+	assert.Equal(t, "2026-10-16T13:00:00Z", prev.Format(time.RFC3339))
+	assert.Equal(t, time.UTC, next.Location())
+}
+
+func TestScheduleNeverFires(t *testing.T) {
+	schedule, err := New().Parse("0 0 30 2 * /command")
+	assert.Nil(t, err)
+
+	from := mustParseTime(t, "2026-10-17T00:00:00Z")
+
+	assert.True(t, schedule.Next(from).IsZero())
+	assert.True(t, schedule.Prev(from).IsZero())
+}
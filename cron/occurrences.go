@@ -0,0 +1,70 @@
+package cron
+
+import "time"
+
+// Occurrences lazily iterates over the fire times of a schedule. Fire times
+// are computed one at a time as the iterator advances, so even a window that
+// spans years costs nothing until it is consumed:
+//
+//	it := schedule.Occurrences(from, to)
+//	for it.Next() {
+//		fmt.Println(it.Time())
+//	}
+type Occurrences struct {
+	schedule *Schedule
+	cursor   time.Time
+	to       time.Time
+	current  time.Time
+	done     bool
+}
+
+// Occurrences returns an iterator over the times in [from, to) at which the
+// schedule fires, in from's location. A zero to leaves the window open ended,
+// the caller is then expected to stop iterating on its own.
+func (s *Schedule) Occurrences(from, to time.Time) *Occurrences {
+	return &Occurrences{
+		schedule: s,
+		// Next is strictly after its argument, step back so from is included
+		cursor: from.Add(-time.Nanosecond),
+		to:     to,
+	}
+}
+
+// Next advances the iterator to the following fire time and reports whether
+// there is one.
+func (o *Occurrences) Next() bool {
+	if o.done {
+		return false
+	}
+
+	next := o.schedule.Next(o.cursor)
+	if next.IsZero() || (!o.to.IsZero() && !next.Before(o.to)) {
+		o.done = true
+		o.current = time.Time{}
+
+		return false
+	}
+
+	o.cursor = next
+	o.current = next
+
+	return true
+}
+
+// Time returns the fire time the iterator is positioned at, or the zero Time
+// before the first call to Next and after the iteration ended.
+func (o *Occurrences) Time() time.Time {
+	return o.current
+}
+
+// NextN returns the next n fire times strictly after t.
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	var result []time.Time
+
+	it := s.Occurrences(t.Add(time.Nanosecond), time.Time{})
+	for len(result) < n && it.Next() {
+		result = append(result, it.Time())
+	}
+
+	return result
+}
@@ -0,0 +1,97 @@
+package cron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func formatTimes(times []time.Time) []string {
+	var result []string
+	for _, each := range times {
+		result = append(result, each.Format(time.RFC3339))
+	}
+
+	return result
+}
+
+func TestOccurrences(t *testing.T) {
+	tests := []struct {
+		msg    string
+		input  string
+		from   string
+		to     string
+		expOut []string
+	}{
+		{
+			msg:    "window includes from and excludes to",
+			input:  "0 */6 * * * /command",
+			from:   "2026-10-17T00:00:00Z",
+			to:     "2026-10-18T00:00:00Z",
+			expOut: []string{"2026-10-17T00:00:00Z", "2026-10-17T06:00:00Z", "2026-10-17T12:00:00Z", "2026-10-17T18:00:00Z"},
+		},
+		{
+			msg:    "all runs in March",
+			input:  "0 12 */10 MAR * /command",
+			from:   "2027-01-01T00:00:00Z",
+			to:     "2028-01-01T00:00:00Z",
+			expOut: []string{"2027-03-01T12:00:00Z", "2027-03-11T12:00:00Z", "2027-03-21T12:00:00Z", "2027-03-31T12:00:00Z"},
+		},
+		{
+			msg:    "empty window",
+			input:  "0 0 1 1 * /command",
+			from:   "2026-02-01T00:00:00Z",
+			to:     "2026-12-31T00:00:00Z",
+			expOut: nil,
+		},
+		{
+			msg:    "schedule that never fires",
+			input:  "0 0 30 2 * /command",
+			from:   "2026-01-01T00:00:00Z",
+			to:     "",
+			expOut: nil,
+		},
+	}
+
+	cron := New()
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.Parse(test.input)
+			assert.Nil(t, err)
+
+			var to time.Time
+			if test.to != "" {
+				to = mustParseTime(t, test.to)
+			}
+
+			var actual []time.Time
+
+			it := schedule.Occurrences(mustParseTime(t, test.from), to)
+			for it.Next() {
+				actual = append(actual, it.Time())
+			}
+
+			assert.Equal(t, test.expOut, formatTimes(actual))
+			assert.False(t, it.Next())
+			assert.True(t, it.Time().IsZero())
+		})
+	}
+}
+
+func TestNextN(t *testing.T) {
+	schedule, err := New().Parse("*/15 0 1,15 * * /command")
+	assert.Nil(t, err)
+
+	actual := schedule.NextN(mustParseTime(t, "2026-10-15T00:15:00Z"), 5)
+
+	assert.Equal(t, []string{
+		"2026-10-15T00:30:00Z",
+		"2026-10-15T00:45:00Z",
+		"2026-11-01T00:00:00Z",
+		"2026-11-01T00:15:00Z",
+		"2026-11-01T00:30:00Z",
+	}, formatTimes(actual))
+
+	assert.Empty(t, schedule.NextN(mustParseTime(t, "2026-10-15T00:15:00Z"), 0))
+}
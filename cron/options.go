@@ -0,0 +1,124 @@
+package cron
+
+import "time"
+
+// Option configures a Parser.
+type Option func(*Parser)
+
+// DayMatching controls how the day of month and day of week fields combine
+// when deciding whether a schedule fires on a given day.
+type DayMatching int
+
+const (
+	// DayMatchVixie is the behaviour of Vixie cron and its descendants: when
+	// both day fields are restricted a day matches if either field matches,
+	// when one of them is "*" only the other one is considered. A field
+	// counts as unrestricted when it starts with "*", so "*/2" is too.
+	DayMatchVixie DayMatching = iota
+	// DayMatchAnd requires both day fields to match, as some schedulers do.
+	DayMatchAnd
+)
+
+func (m DayMatching) String() string {
+	switch m {
+	case DayMatchVixie:
+		return "vixie"
+	case DayMatchAnd:
+		return "and"
+	default:
+		return "unknown"
+	}
+}
+
+// WithDayMatching sets how the day of month and day of week fields combine.
+// The default is DayMatchVixie.
+func WithDayMatching(m DayMatching) Option {
+	return func(p *Parser) {
+		p.dayMatching = m
+	}
+}
+
+// WithDialect makes the parser read expressions in the syntax of a
+// platform, such as Quartz or Kubernetes: the dialect sets the fields, their
+// bounds and names, the extensions that are accepted and how the day fields
+// combine. Options are applied in order, so WithDayMatching after
+// WithDialect overrides the day matching of the dialect, and WithSeconds and
+// WithYear add their field to a dialect that lacks it. The default is
+// Standard.
+func WithDialect(d Dialect) Option {
+	return func(p *Parser) {
+		p.dialect = d
+		p.dayMatching = d.DayMatching
+	}
+}
+
+// WithSeconds makes the parser expect a leading seconds field (0-59), as in
+// the six field expressions of Spring and Quartz, e.g. "30 */15 * * * *".
+// Schedules parsed this way fire at second resolution.
+func WithSeconds() Option {
+	return func(p *Parser) {
+		p.seconds = true
+	}
+}
+
+// WithSystemCrontab makes Parse expect the user to run the command as
+// between the time fields and the command, as in /etc/crontab and the files
+// of /etc/cron.d, e.g. "0 4 * * * root /usr/sbin/logrotate". The user is
+// available from Schedule.User.
+func WithSystemCrontab() Option {
+	return func(p *Parser) {
+		p.systemCrontab = true
+	}
+}
+
+// WithYear makes the parser expect a trailing year field (1970-2199) after
+// the day of week, as in seven field Quartz expressions. Without this option
+// ParseExpression still accepts a year when the expression has exactly one
+// field more than expected, Parse cannot tell a year from the command and
+// needs the option.
+func WithYear() Option {
+	return func(p *Parser) {
+		p.year = true
+	}
+}
+
+// WithIntervalStart sets the time "@every" schedules are anchored to: they
+// fire at start and every interval after it. The default is the Unix epoch,
+// so that "@every 1h" fires on the hour.
+func WithIntervalStart(start time.Time) Option {
+	return func(p *Parser) {
+		p.intervalStart = start
+	}
+}
+
+// WithHashSeed enables the Jenkins style hash tokens H, H(a-b), H/n and
+// H(a-b)/n. Each resolves to values derived from seed, typically the name of
+// a job, so that jobs written as "H H * * *" spread over the day instead of
+// all firing at midnight while every job keeps the same time from one parse
+// to the next. A bare H in the day of month field stays within 1-28.
+func WithHashSeed(seed string) Option {
+	return func(p *Parser) {
+		p.hashSeed = seed
+	}
+}
+
+// WithWrapAround allows ranges whose start is after their end, which then
+// wrap past the upper bound of the field: "22-2" in the hour field means
+// 22,23,0,1,2, "NOV-FEB" in the month field means 11,12,1,2 and "50-10/5" in
+// the minute field means 50,55,0,5,10.
+func WithWrapAround() Option {
+	return func(p *Parser) {
+		p.wrapAround = true
+	}
+}
+
+// WithRandomSource sets where the values of OpenBSD style random tokens such
+// as "0~30" or "~" come from. Each token is resolved once, when the
+// expression is parsed. The default uses math/rand, pass a *rand.Rand with a
+// fixed seed for reproducible results. The parser serializes its calls to
+// random, so the Parser stays safe for concurrent use.
+func WithRandomSource(random RandomSource) Option {
+	return func(p *Parser) {
+		p.random = &lockedRandom{source: random}
+	}
+}
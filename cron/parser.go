@@ -0,0 +1,478 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CronField expands the text of a single cron field (e.g. "*/15" or "1-5")
+// into the values it allows.
+type CronField interface {
+	Expand(field string) ([]string, error)
+}
+
+// Parser parses cron expressions into schedules. A Parser holds no state
+// between calls and is safe for concurrent use.
+type Parser struct {
+	dialect     Dialect
+	fields      []Field
+	optional    []Field
+	parsers     map[Field]CronField
+	dayMatching DayMatching
+	seconds     bool
+	year        bool
+	hashSeed    string
+	wrapAround  bool
+	random      RandomSource
+
+	systemCrontab bool
+	intervalStart time.Time
+}
+
+// New returns a Parser for standard five-field cron expressions, configured
+// by the given options.
+func New(opts ...Option) *Parser {
+	p := &Parser{
+		dialect:       Standard,
+		dayMatching:   Standard.DayMatching,
+		intervalStart: time.Unix(0, 0).UTC(),
+		random:        globalRandom{},
+		parsers: map[Field]CronField{
+			Second:     newSecond(),
+			Minute:     newMinute(),
+			Hour:       newHour(),
+			DayOfMonth: newDayOfMonth(),
+			Month:      newMonth(),
+			DayOfWeek:  newDayOfWeek(),
+			Year:       newYear(),
+		},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.seconds && !p.dialect.has(Second) {
+		p.fields = append(p.fields, Second)
+	}
+
+	for _, spec := range p.dialect.Fields {
+		p.parsers[spec.Field] = newField(spec)
+
+		if spec.Optional && !(spec.Field == Year && p.year) {
+			p.optional = append(p.optional, spec.Field)
+			continue
+		}
+
+		p.fields = append(p.fields, spec.Field)
+	}
+
+	if p.year && !p.dialect.has(Year) {
+		p.fields = append(p.fields, Year)
+	}
+
+	return p
+}
+
+// Parse parses a cron expression followed by a command and returns the
+// expanded schedule. It has no side effects, so a single Parser can be used
+// to parse any number of expressions. Instead of the fields the expression
+// can be one of the macros @yearly, @annually, @monthly, @weekly, @daily,
+// @midnight, @hourly and @reboot, or "@every <duration>". A leading
+// CRON_TZ= or TZ= assignment, as in "CRON_TZ=Europe/London 0 9 * * * cmd",
+// sets the time zone the schedule is evaluated in. WithSystemCrontab parsers
+// expect a user between the expression and the command.
+func (p *Parser) Parse(input string) (*Schedule, error) {
+	loc, input, err := splitTimeZone(input)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := p.parseLine(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.systemCrontab {
+		user, command, ok := cutFields(schedule.command, 1)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a user and a command", ErrInvalidFormat)
+		}
+
+		schedule.user, schedule.command = user[0], command
+	}
+
+	schedule.location = p.location(loc)
+
+	return schedule, nil
+}
+
+func (p *Parser) parseLine(input string) (*Schedule, error) {
+	if schedule, command, ok, err := p.parseEventBridge(input); ok {
+		if err != nil {
+			return nil, err
+		}
+
+		if command == "" {
+			return nil, ErrInvalidFormat
+		}
+
+		schedule.command = command
+
+		return schedule, nil
+	}
+
+	if isMacro(input) {
+		tokens, command, ok := cutFields(input, 1)
+		if !ok {
+			return nil, ErrInvalidFormat
+		}
+
+		if strings.EqualFold(tokens[0], everyMacro) {
+			tokens, command, ok = cutFields(command, 1)
+			if !ok {
+				return nil, ErrInvalidFormat
+			}
+
+			return p.parseEvery(tokens[0], command)
+		}
+
+		return p.parseMacro(tokens[0], command)
+	}
+
+	fields := p.fields
+
+	// an optional trailing field is only taken if the token after the
+	// required fields is valid for it, otherwise it starts the command
+	if tokens, _, ok := cutFields(input, len(fields)+len(p.optional)); ok && len(p.optional) > 0 {
+		if p.accepts(p.optional[0], tokens[len(fields)]) {
+			fields = append(append([]Field(nil), fields...), p.optional...)
+		}
+	}
+
+	tokens, command, ok := cutFields(input, len(fields))
+	if !ok {
+		// too few fields is a bad expression, only a missing command is not
+		if len(strings.Fields(input)) < len(fields) {
+			return nil, validate(fields, input)
+		}
+
+		return nil, ErrInvalidFormat
+	}
+
+	return p.parse(fields, strings.Join(tokens, " "), command)
+}
+
+// accepts reports whether token is a valid value for field.
+func (p *Parser) accepts(field Field, token string) bool {
+	token, err := p.resolve(field, token)
+	if err == nil {
+		_, err = p.parsers[field].Expand(token)
+	}
+
+	return err == nil
+}
+
+// cutFields splits the first n fields, separated by spaces or tabs, off
+// input and returns them with the rest of the input, which keeps its inner
+// spacing. It reports false if input has no text after the n fields.
+func cutFields(input string, n int) ([]string, string, bool) {
+	tokens := make([]string, 0, n)
+	rest := strings.TrimLeft(input, " \t")
+
+	for len(tokens) < n {
+		end := strings.IndexAny(rest, " \t")
+		if end < 0 {
+			return nil, "", false
+		}
+
+		tokens = append(tokens, rest[:end])
+		rest = strings.TrimLeft(rest[end:], " \t")
+	}
+
+	if rest == "" {
+		return nil, "", false
+	}
+
+	return tokens, rest, true
+}
+
+// ParseExpression parses a cron expression that is not followed by a
+// command, e.g. "*/15 0 1,15 * 1-5". The command of the returned schedule is
+// empty. With the Standard dialect an expression with one field more than
+// expected is read as having a trailing year field, other dialects declare
+// optional fields in their layout. Macros such as "@daily" and a leading CRON_TZ= or
+// TZ= assignment are accepted as well.
+func (p *Parser) ParseExpression(expr string) (*Schedule, error) {
+	loc, expr, err := splitTimeZone(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := p.parseExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.location = p.location(loc)
+
+	return schedule, nil
+}
+
+func (p *Parser) parseExpression(expr string) (*Schedule, error) {
+	if schedule, rest, ok, err := p.parseEventBridge(expr); ok {
+		if err == nil && rest != "" {
+			err = fmt.Errorf("%w: unexpected text after the expression: %s", ErrInvalidExpression, rest)
+		}
+
+		return schedule, err
+	}
+
+	if isMacro(expr) {
+		tokens := strings.Fields(expr)
+		if strings.EqualFold(tokens[0], everyMacro) && len(tokens) == 2 {
+			return p.parseEvery(tokens[1], "")
+		}
+
+		if len(tokens) != 1 {
+			return nil, fmt.Errorf("%w: unexpected fields after macro %s", ErrInvalidExpression, tokens[0])
+		}
+
+		return p.parseMacro(tokens[0], "")
+	}
+
+	fields := p.fields
+	if len(strings.Fields(expr)) == len(fields)+1 {
+		switch {
+		case len(p.optional) > 0:
+			fields = append(append([]Field(nil), fields...), p.optional...)
+		case p.dialect.yearByCount && !p.year:
+			fields = append(append([]Field(nil), fields...), Year)
+		}
+	}
+
+	return p.parse(fields, expr, "")
+}
+
+// location returns the time zone of a schedule, the one of its CRON_TZ=
+// prefix if it had one and the one of the dialect otherwise.
+func (p *Parser) location(prefix *time.Location) *time.Location {
+	if prefix != nil {
+		return prefix
+	}
+
+	return p.dialect.Location
+}
+
+// splitTimeZone removes a leading CRON_TZ= or TZ= assignment from input and
+// returns the location it names, or nil if there is none.
+func splitTimeZone(input string) (*time.Location, string, error) {
+	first := strings.TrimLeft(input, " \t")
+	rest := ""
+
+	if end := strings.IndexAny(first, " \t"); end >= 0 {
+		first, rest = first[:end], first[end:]
+	}
+
+	var name string
+
+	switch {
+	case strings.HasPrefix(first, "CRON_TZ="):
+		name = strings.TrimPrefix(first, "CRON_TZ=")
+	case strings.HasPrefix(first, "TZ="):
+		name = strings.TrimPrefix(first, "TZ=")
+	default:
+		return nil, input, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "" {
+		return nil, "", fmt.Errorf("%w: unknown time zone %q", ErrInvalidExpression, name)
+	}
+
+	return loc, strings.TrimLeft(rest, " \t"), nil
+}
+
+func (p *Parser) parse(fields []Field, cronExpr, command string) (*Schedule, error) {
+	err := validate(fields, cronExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := strings.Fields(cronExpr)
+
+	err = p.checkQuestionMarks(fields, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	values, specs, err := p.expand(fields, tokens)
+	if err != nil {
+		return nil, fmt.Errorf("error in expanding cron expression: %s, err: %w", cronExpr, err)
+	}
+
+	schedule := &Schedule{
+		fields:      append([]Field(nil), fields...),
+		tokens:      make(map[Field]string, len(fields)),
+		values:      values,
+		specs:       specs,
+		command:     command,
+		dayMatching: p.dayMatching,
+	}
+
+	for i, field := range fields {
+		schedule.tokens[field] = tokens[i]
+	}
+
+	return schedule, nil
+}
+
+func validate(fields []Field, exp string) error {
+	tokens := strings.Fields(exp)
+
+	if len(tokens) != len(fields) {
+		return fmt.Errorf("%w: expected %d fields, got %d", ErrInvalidExpression, len(fields), len(tokens))
+	}
+
+	return nil
+}
+
+// checkQuestionMarks enforces ? in exactly one of the day fields for the
+// dialects that require it.
+func (p *Parser) checkQuestionMarks(fields []Field, tokens []string) error {
+	if !p.dialect.RequireQuestionMark {
+		return nil
+	}
+
+	var marks int
+
+	for i, field := range fields {
+		if (field == DayOfMonth || field == DayOfWeek) && tokens[i] == noSpecificValue {
+			marks++
+		}
+	}
+
+	if marks != 1 {
+		return fmt.Errorf("%w: the %s dialect requires ? in exactly one of the day of month and day of week fields", ErrInvalidExpression, p.dialect.Name)
+	}
+
+	return nil
+}
+
+func (p *Parser) expand(fields []Field, tokens []string) (map[Field][]int, map[Field][]daySpec, error) {
+	values := make(map[Field][]int, len(fields))
+	specs := make(map[Field][]daySpec)
+
+	for i, field := range fields {
+		parser := p.parsers[field]
+
+		// ? leaves the field without values, the other day field decides
+		if tokens[i] == noSpecificValue {
+			err := p.checkQuestionMark(field)
+			if err != nil {
+				return nil, nil, &ParseError{Field: field, Value: tokens[i], Err: err}
+			}
+
+			continue
+		}
+
+		token, err := p.resolve(field, tokens[i])
+		if err != nil {
+			return nil, nil, &ParseError{Field: field, Value: tokens[i], Err: err}
+		}
+
+		parsed, err := parser.Expand(token)
+		if err != nil {
+			return nil, nil, &ParseError{Field: field, Value: tokens[i], Err: err}
+		}
+
+		// calendar dependent days such as "L" are kept apart from the values,
+		// Expand returns them numbered like the standard fields
+		var plain []string
+
+		specParser, isDayField := canonicalDayFields[field]
+
+		for _, value := range parsed {
+			if !isDayField {
+				plain = append(plain, value)
+				continue
+			}
+
+			spec, isSpec, err := specParser.daySpec(value)
+			if err != nil {
+				return nil, nil, &ParseError{Field: field, Value: tokens[i], Err: err}
+			}
+
+			if isSpec && !p.dialect.allows(spec.kind.special()) {
+				return nil, nil, &ParseError{Field: field, Value: tokens[i], Err: p.dialect.unsupported(spec.kind.syntax())}
+			}
+
+			if isSpec {
+				specs[field] = append(specs[field], spec)
+			} else {
+				plain = append(plain, value)
+			}
+		}
+
+		values[field], err = toSet(plain)
+		if err != nil {
+			return nil, nil, &ParseError{Field: field, Value: tokens[i], Err: err}
+		}
+	}
+
+	return values, specs, nil
+}
+
+// resolve rewrites the tokens of a field that depend on parser options, such
+// as H, ~ or wrap-around ranges, into plain values the field can expand.
+func (p *Parser) resolve(field Field, token string) (string, error) {
+	parser, ok := p.parsers[field].(boundedField)
+	if !ok {
+		return token, nil
+	}
+
+	min, max := parser.bounds()
+
+	if !p.dialect.allows(SpecialHash) && hasHash(token) {
+		return "", p.dialect.unsupported("H")
+	}
+
+	if !p.dialect.allows(SpecialRandom) && strings.Contains(token, "~") {
+		return "", p.dialect.unsupported("~")
+	}
+
+	token, err := resolveHash(token, p.hashSeed, field, min, max)
+	if err != nil {
+		return "", err
+	}
+
+	token, err = resolveRandom(token, p.random, min, max)
+	if err != nil || !p.wrapAround {
+		return token, err
+	}
+
+	// names have to become numbers before the order of a range is known
+	if aliased, ok := parser.(aliasedField); ok {
+		token = replaceAliases(token, aliased.aliases())
+	}
+
+	if weekdays, ok := parser.(*dayOfWeek); ok {
+		token = wrapSunday(token, weekdays.min, weekdays.max)
+	}
+
+	return wrapRanges(token, min, max)
+}
+
+// checkQuestionMark reports whether a ? is allowed in field.
+func (p *Parser) checkQuestionMark(field Field) error {
+	if !p.dialect.allows(SpecialQuestionMark) {
+		return p.dialect.unsupported(noSpecificValue)
+	}
+
+	if field != DayOfMonth && field != DayOfWeek {
+		return fmt.Errorf("? is only allowed in the day of month and day of week fields")
+	}
+
+	return nil
+}
@@ -0,0 +1,366 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		msg      string
+		input    string
+		expError error
+	}{
+		{"the input has 5 space separated cron fields", "*/15 0 1,15 * 1-5", nil},
+		{"the input has 5 space separated any fields", "a b c d e", nil},
+		{"the input has 0 fields", "", errors.New("invalid cron expression: expected 5 fields, got 0")},
+		{"the input has 3 fields", "a b c", errors.New("invalid cron expression: expected 5 fields, got 3")},
+		{"the input has 6 fields", "a b c d e f", errors.New("invalid cron expression: expected 5 fields, got 6")},
+	}
+
+	cron := New()
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			actualErr := validate(cron.fields, test.input)
+
+			if test.expError != nil {
+				assert.EqualError(t, actualErr, test.expError.Error())
+				assert.ErrorIs(t, actualErr, ErrInvalidExpression)
+			} else {
+				assert.Nil(t, actualErr)
+			}
+		})
+	}
+}
+
+func TestExpandCron(t *testing.T) {
+	tests := []struct {
+		msg   string
+		input string
+
+		expOutMinute     []int
+		expOutHour       []int
+		expOutDayOfMonth []int
+		expOutMonth      []int
+		expOutDayOfWeek  []int
+
+		expError error
+	}{
+		{
+			msg:              "Test case for simple cron expression",
+			input:            "0-59 0-23 1-31 1-12 0-6 /command",
+			expOutMinute:     []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59},
+			expOutHour:       []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23},
+			expOutDayOfMonth: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31},
+			expOutMonth:      []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			expOutDayOfWeek:  []int{0, 1, 2, 3, 4, 5, 6},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case with step values",
+			input:            "*/15 */6 */10 */2 */2 /command",
+			expOutMinute:     []int{0, 15, 30, 45},
+			expOutHour:       []int{0, 6, 12, 18},
+			expOutDayOfMonth: []int{1, 11, 21, 31},
+			expOutMonth:      []int{1, 3, 5, 7, 9, 11},
+			expOutDayOfWeek:  []int{0, 2, 4, 6},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case with specific values",
+			input:            "0,30 9,21 1,15 1,7 1,3,5 /command",
+			expOutMinute:     []int{0, 30},
+			expOutHour:       []int{9, 21},
+			expOutDayOfMonth: []int{1, 15},
+			expOutMonth:      []int{1, 7},
+			expOutDayOfWeek:  []int{1, 3, 5},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case for asterisk values",
+			input:            "* * * * * /command",
+			expOutMinute:     []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59},
+			expOutHour:       []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23},
+			expOutDayOfMonth: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31},
+			expOutMonth:      []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			expOutDayOfWeek:  []int{0, 1, 2, 3, 4, 5, 6},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case with invalid minute value",
+			input:            "60 12 10 5 3 /command",
+			expOutMinute:     nil,
+			expOutHour:       nil,
+			expOutDayOfMonth: nil,
+			expOutMonth:      nil,
+			expOutDayOfWeek:  nil,
+			expError:         errors.New("error in expanding cron expression"),
+		},
+		{
+			msg:              "Test case with invalid day of month value",
+			input:            "30 14 -1 6 4 /command",
+			expOutMinute:     nil,
+			expOutHour:       nil,
+			expOutDayOfMonth: nil,
+			expOutMonth:      nil,
+			expOutDayOfWeek:  nil,
+			expError:         errors.New("error in expanding cron expression"),
+		},
+		{
+			msg:              "Test case with mixed list and range",
+			input:            "0,15-30,45 5,9-11,14 1-10,15,20-25 1,6-8,11 0,3-5,7 /command",
+			expOutMinute:     []int{0, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 45},
+			expOutHour:       []int{5, 9, 10, 11, 14},
+			expOutDayOfMonth: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 15, 20, 21, 22, 23, 24, 25},
+			expOutMonth:      []int{1, 6, 7, 8, 11},
+			expOutDayOfWeek:  []int{0, 3, 4, 5},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case with combined wildcards and list",
+			input:            "0,30 */12 */5 */3 1,3,5 /command",
+			expOutMinute:     []int{0, 30},
+			expOutHour:       []int{0, 12},
+			expOutDayOfMonth: []int{1, 6, 11, 16, 21, 26, 31},
+			expOutMonth:      []int{1, 4, 7, 10},
+			expOutDayOfWeek:  []int{1, 3, 5},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case with complex range and step",
+			input:            "0-59/20 0-23/8 1-30/10 1-12/4 0-6/2 /command",
+			expOutMinute:     []int{0, 20, 40},
+			expOutHour:       []int{0, 8, 16},
+			expOutDayOfMonth: []int{1, 11, 21},
+			expOutMonth:      []int{1, 5, 9},
+			expOutDayOfWeek:  []int{0, 2, 4, 6},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case with month and weekday names",
+			input:            "0 9 1 jan,JUL-SEP MON-FRI /command",
+			expOutMinute:     []int{0},
+			expOutHour:       []int{9},
+			expOutDayOfMonth: []int{1},
+			expOutMonth:      []int{1, 7, 8, 9},
+			expOutDayOfWeek:  []int{1, 2, 3, 4, 5},
+			expError:         nil,
+		},
+		{
+			msg:              "Test case with non-numeric values",
+			input:            "a b c d e /command",
+			expOutMinute:     nil,
+			expOutHour:       nil,
+			expOutDayOfMonth: nil,
+			expOutMonth:      nil,
+			expOutDayOfWeek:  nil,
+			expError:         errors.New("error in expanding cron expression"),
+		},
+		{
+			msg:              "Test case with step ranges out of bounds",
+			input:            "0 1-31/2 * 2030-2040/5 * /command",
+			expOutMinute:     nil,
+			expOutHour:       nil,
+			expOutDayOfMonth: nil,
+			expOutMonth:      nil,
+			expOutDayOfWeek:  nil,
+			expError:         errors.New("invalid range values: 1-31/2"),
+		},
+		{
+			msg:              "Test case with missing fields",
+			input:            "30  10  3 /command",
+			expOutMinute:     nil,
+			expOutHour:       nil,
+			expOutDayOfMonth: nil,
+			expOutMonth:      nil,
+			expOutDayOfWeek:  nil,
+			expError:         errors.New("invalid cron expression"),
+		},
+	}
+
+	cron := New()
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, actualErr := cron.Parse(test.input)
+
+			if actualErr != nil {
+				assert.ErrorContains(t, actualErr, test.expError.Error())
+				assert.Nil(t, schedule)
+			} else {
+				assert.Nil(t, test.expError)
+				assert.Equal(t, test.expOutMinute, schedule.Values(Minute))
+				assert.Equal(t, test.expOutHour, schedule.Values(Hour))
+				assert.Equal(t, test.expOutDayOfMonth, schedule.Values(DayOfMonth))
+				assert.Equal(t, test.expOutMonth, schedule.Values(Month))
+				assert.Equal(t, test.expOutDayOfWeek, schedule.Values(DayOfWeek))
+				assert.Equal(t, "/command", schedule.Command())
+			}
+		})
+	}
+}
+
+func TestParseExpression(t *testing.T) {
+	cron := New()
+
+	schedule, err := cron.ParseExpression("*/15 0 1,15 * 1-5")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 15, 30, 45}, schedule.Values(Minute))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, schedule.Values(DayOfWeek))
+	assert.Equal(t, "", schedule.Command())
+
+	_, err = cron.ParseExpression("*/15 0 1,15 * 1-5 /usr/bin/find")
+	assert.ErrorContains(t, err, "error in parsing year")
+
+	_, err = cron.ParseExpression("*/15 0 1,15 * 1-5 2027 /usr/bin/find")
+	assert.ErrorIs(t, err, ErrInvalidExpression)
+}
+
+func TestParseWithYear(t *testing.T) {
+	tests := []struct {
+		msg       string
+		opts      []Option
+		input     string
+		expFields []Field
+		expYear   []int
+	}{
+		{"detected by field count", nil, "0 0 1 1 * 2027", []Field{Minute, Hour, DayOfMonth, Month, DayOfWeek, Year}, []int{2027}},
+		{"not detected without an extra field", nil, "0 0 1 1 *", []Field{Minute, Hour, DayOfMonth, Month, DayOfWeek}, nil},
+		{"explicit mode", []Option{WithYear()}, "0 0 1 1 * 2027-2030/2", []Field{Minute, Hour, DayOfMonth, Month, DayOfWeek, Year}, []int{2027, 2029}},
+		{"quartz expression", []Option{WithSeconds()}, "0 0 12 * * * 2030", []Field{Second, Minute, Hour, DayOfMonth, Month, DayOfWeek, Year}, []int{2030}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := New(test.opts...).ParseExpression(test.input)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expFields, schedule.Fields())
+			assert.Equal(t, test.expYear, schedule.Values(Year))
+		})
+	}
+
+	schedule, err := New(WithYear()).Parse("0 0 1 1 * 2027,2029 /usr/bin/find -name core")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2027, 2029}, schedule.Values(Year))
+	assert.Equal(t, "/usr/bin/find -name core", schedule.Command())
+
+	_, err = New(WithYear()).ParseExpression("0 0 1 1 * 1969")
+	assert.ErrorContains(t, err, "error in parsing year. err: invalid value: 1969")
+
+	_, err = New(WithYear()).ParseExpression("0 0 1 1 *")
+	assert.ErrorContains(t, err, "expected 6 fields, got 5")
+}
+
+func TestParseWithSeconds(t *testing.T) {
+	cron := New(WithSeconds())
+
+	schedule, err := cron.Parse("*/20 0 9 * * MON-FRI /usr/bin/report")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []Field{Second, Minute, Hour, DayOfMonth, Month, DayOfWeek}, schedule.Fields())
+	assert.Equal(t, []int{0, 20, 40}, schedule.Values(Second))
+	assert.Equal(t, []int{0}, schedule.Values(Minute))
+	assert.Equal(t, []int{9}, schedule.Values(Hour))
+	assert.Equal(t, "/usr/bin/report", schedule.Command())
+
+	_, err = cron.ParseExpression("0 9 * * MON-FRI")
+	assert.ErrorContains(t, err, "invalid cron expression: expected 6 fields, got 5")
+
+	_, err = cron.ParseExpression("60 0 9 * * MON-FRI")
+	assert.ErrorContains(t, err, "error in parsing second. err: invalid value: 60")
+}
+
+func TestParseSpecials(t *testing.T) {
+	schedule, err := New().Parse("0 12 1,L,15W * MON#2,FRIL /command")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []int{1}, schedule.Values(DayOfMonth))
+	assert.Equal(t, []string{"L", "15W"}, schedule.Specials(DayOfMonth))
+	assert.Nil(t, schedule.Values(DayOfWeek))
+	assert.Equal(t, []string{"1#2", "5L"}, schedule.Specials(DayOfWeek))
+	assert.Nil(t, schedule.Specials(Hour))
+}
+
+func TestParseTimeZone(t *testing.T) {
+	tests := []struct {
+		msg        string
+		input      string
+		expZone    string
+		expHours   []int
+		expCommand string
+	}{
+		{"cron tz prefix", "CRON_TZ=America/New_York 0 9 * * 1-5 /usr/bin/report", "America/New_York", []int{9}, "/usr/bin/report"},
+		{"tz prefix", "TZ=Asia/Tokyo 0 9 * * 1-5 /usr/bin/report", "Asia/Tokyo", []int{9}, "/usr/bin/report"},
+		{"prefix before a macro", "CRON_TZ=Europe/London  @daily /usr/bin/report", "Europe/London", []int{0}, "/usr/bin/report"},
+		{"without prefix", "0 9 * * 1-5 /usr/bin/report", "", []int{9}, "/usr/bin/report"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := New().Parse(test.input)
+			if err != nil && strings.Contains(err.Error(), "unknown time zone") {
+				t.Skipf("time zone database not available: %s", err)
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expCommand, schedule.Command())
+			assert.Equal(t, test.expHours, schedule.Values(Hour))
+
+			if test.expZone == "" {
+				assert.Nil(t, schedule.Location())
+			} else {
+				assert.Equal(t, test.expZone, schedule.Location().String())
+			}
+		})
+	}
+
+	schedule, err := New().ParseExpression("CRON_TZ=UTC 0 9 * * 1-5")
+	assert.Nil(t, err)
+	assert.Equal(t, time.UTC, schedule.Location())
+
+	_, err = New().Parse("CRON_TZ=Mars/Olympus_Mons 0 9 * * 1-5 /usr/bin/report")
+	assert.EqualError(t, err, `invalid cron expression: unknown time zone "Mars/Olympus_Mons"`)
+
+	_, err = New().Parse("CRON_TZ= 0 9 * * 1-5 /usr/bin/report")
+	assert.ErrorIs(t, err, ErrInvalidExpression)
+}
+
+func TestParseErrors(t *testing.T) {
+	cron := New()
+
+	_, err := cron.Parse("*/15 0 1,15 * 1-5")
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	_, err = cron.Parse("*/15 0 1,15 * 9 /usr/bin/find")
+
+	var parseErr *ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, DayOfWeek, parseErr.Field)
+	assert.Equal(t, "9", parseErr.Value)
+	assert.ErrorContains(t, err, "error in parsing day of week. err: invalid value: 9")
+}
+
+func TestParseReturnsIndependentSchedules(t *testing.T) {
+	cron := New()
+
+	first, err := cron.Parse("*/15 0 1,15 * 1-5 /usr/bin/find")
+	assert.Nil(t, err)
+
+	second, err := cron.Parse("0 12 * * 0,7 /usr/bin/backup --full")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []Field{Minute, Hour, DayOfMonth, Month, DayOfWeek}, first.Fields())
+	assert.Equal(t, []int{0, 15, 30, 45}, first.Values(Minute))
+	assert.Equal(t, "/usr/bin/find", first.Command())
+
+	assert.Equal(t, []int{0}, second.Values(DayOfWeek))
+	assert.Equal(t, "/usr/bin/backup --full", second.Command())
+
+	// mutating the returned values must not leak into the schedule
+	values := first.Values(Hour)
+	values[0] = 23
+	assert.Equal(t, []int{0}, first.Values(Hour))
+}
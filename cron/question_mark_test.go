@@ -0,0 +1,150 @@
+package cron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseQuestionMark(t *testing.T) {
+	tests := []struct {
+		msg                string
+		dialect            Dialect
+		input              string
+		expDayOfMonth      []int
+		expDayOfWeek       []int
+		expNoSpecificDay   []Field
+		expMatchEitherDays bool
+	}{
+		{
+			msg:              "standard day of week",
+			dialect:          Standard,
+			input:            "0 0 13 * ?",
+			expDayOfMonth:    []int{13},
+			expNoSpecificDay: []Field{DayOfWeek},
+		},
+		{
+			msg:              "kubernetes day of month",
+			dialect:          Kubernetes,
+			input:            "0 9 ? * MON",
+			expDayOfWeek:     []int{1},
+			expNoSpecificDay: []Field{DayOfMonth},
+		},
+		{
+			msg:              "spring both day fields",
+			dialect:          Spring,
+			input:            "0 0 9 ? * ?",
+			expNoSpecificDay: []Field{DayOfMonth, DayOfWeek},
+		},
+		{
+			msg:              "quartz day of week",
+			dialect:          Quartz,
+			input:            "0 15 10 L * ?",
+			expNoSpecificDay: []Field{DayOfWeek},
+		},
+		{
+			msg:                "standard without question mark",
+			dialect:            Standard,
+			input:              "0 0 13 * 5",
+			expDayOfMonth:      []int{13},
+			expDayOfWeek:       []int{5},
+			expMatchEitherDays: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := New(WithDialect(test.dialect)).ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			var noSpecific []Field
+			for _, field := range []Field{DayOfMonth, DayOfWeek} {
+				if schedule.NoSpecificValue(field) {
+					noSpecific = append(noSpecific, field)
+
+					// ? is not a wildcard, it has no values at all
+					assert.False(t, schedule.Wildcard(field))
+				}
+			}
+
+			assert.Equal(t, test.expDayOfMonth, schedule.Values(DayOfMonth))
+			assert.Equal(t, test.expDayOfWeek, schedule.Values(DayOfWeek))
+			assert.Equal(t, test.expNoSpecificDay, noSpecific)
+			assert.Equal(t, test.expMatchEitherDays, schedule.MatchesEitherDay())
+		})
+	}
+}
+
+func TestQuestionMarkNext(t *testing.T) {
+	from := mustParseTime(t, "2026-10-17T00:00:00Z")
+
+	tests := []struct {
+		msg      string
+		dialect  Dialect
+		input    string
+		expTimes []string
+	}{
+		{
+			// a ? day of week does not turn on the either day rule
+			msg:      "thirteenth of the month",
+			dialect:  Standard,
+			input:    "0 0 13 * ?",
+			expTimes: []string{"2026-11-13T00:00:00Z", "2026-12-13T00:00:00Z"},
+		},
+		{
+			msg:      "thirteenth or friday",
+			dialect:  Standard,
+			input:    "0 0 13 * 5",
+			expTimes: []string{"2026-10-23T00:00:00Z", "2026-10-30T00:00:00Z"},
+		},
+		{
+			msg:      "every day",
+			dialect:  Spring,
+			input:    "0 0 9 ? * ?",
+			expTimes: []string{"2026-10-17T09:00:00Z", "2026-10-18T09:00:00Z"},
+		},
+		{
+			msg:      "last day of the month",
+			dialect:  Quartz,
+			input:    "0 15 10 L * ?",
+			expTimes: []string{"2026-10-31T10:15:00Z", "2026-11-30T10:15:00Z"},
+		},
+		{
+			msg:      "second monday",
+			dialect:  Quartz,
+			input:    "0 0 8 ? * MON#2",
+			expTimes: []string{"2026-11-09T08:00:00Z", "2026-12-14T08:00:00Z"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := New(WithDialect(test.dialect)).ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			assert.Equal(t, test.expTimes, formatTimes(schedule.NextN(from, 2)))
+		})
+	}
+}
+
+func TestQuestionMarkErrors(t *testing.T) {
+	tests := []struct {
+		msg      string
+		dialect  Dialect
+		input    string
+		expError string
+	}{
+		{"vixie", Vixie, "0 0 13 * ?", "error in parsing day of week. err: ? is not supported by the vixie dialect"},
+		{"outside the day fields", Standard, "0 ? 13 * *", "error in parsing hour. err: ? is only allowed in the day of month and day of week fields"},
+		{"quartz without question mark", Quartz, "0 0 12 * * MON", "invalid cron expression: the quartz dialect requires ? in exactly one of the day of month and day of week fields"},
+		{"quartz with two question marks", Quartz, "0 0 12 ? * ?", "invalid cron expression: the quartz dialect requires ? in exactly one of the day of month and day of week fields"},
+		{"question mark in a list", Standard, "0 0 13 * ?,5", "error in parsing day of week. err: invalid value: ?"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			_, err := New(WithDialect(test.dialect)).ParseExpression(test.input)
+
+			assert.ErrorContains(t, err, test.expError)
+		})
+	}
+}
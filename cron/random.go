@@ -0,0 +1,73 @@
+package cron
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RandomSource picks the values of OpenBSD style random tokens such as
+// "0~30". *rand.Rand satisfies it, which makes results reproducible when it
+// is created with a fixed seed.
+type RandomSource interface {
+	// Intn returns a number in [0, n).
+	Intn(n int) int
+}
+
+// globalRandom uses the top level functions of math/rand, which are safe
+// for concurrent use.
+type globalRandom struct{}
+
+func (globalRandom) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+// lockedRandom serializes the calls to a RandomSource given with
+// WithRandomSource, since sources such as *rand.Rand are not safe for
+// concurrent use.
+type lockedRandom struct {
+	mu     sync.Mutex
+	source RandomSource
+}
+
+func (l *lockedRandom) Intn(n int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.source.Intn(n)
+}
+
+// resolveRandom replaces the OpenBSD style random tokens "a~b", "a~", "~b"
+// and "~" in a field with a value picked from random, where a missing bound
+// is the bound of the field. Elements without "~" are returned unchanged.
+func resolveRandom(value string, random RandomSource, min, max int) (string, error) {
+	elements := strings.Split(value, ",")
+
+	for i, element := range elements {
+		startPart, endPart, isRandom := strings.Cut(element, "~")
+		if !isRandom {
+			continue
+		}
+
+		start, end := min, max
+
+		var err1, err2 error
+		if startPart != "" {
+			start, err1 = strconv.Atoi(startPart)
+		}
+
+		if endPart != "" {
+			end, err2 = strconv.Atoi(endPart)
+		}
+
+		if err1 != nil || err2 != nil || start < min || end > max || start > end {
+			return "", fmt.Errorf("invalid random range: %s", element)
+		}
+
+		elements[i] = strconv.Itoa(start + random.Intn(end-start+1))
+	}
+
+	return strings.Join(elements, ","), nil
+}
@@ -0,0 +1,100 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// fixedRandom always picks the same offset, clamped to the range asked for.
+type fixedRandom int
+
+func (f fixedRandom) Intn(n int) int {
+	if int(f) >= n {
+		return n - 1
+	}
+
+	return int(f)
+}
+
+func TestResolveRandom(t *testing.T) {
+	tests := []struct {
+		msg    string
+		value  string
+		min    int
+		max    int
+		expOut string
+		expErr error
+	}{
+		{"Without random token", "*/15", 0, 59, "*/15", nil},
+		{"Random within a range", "0~30", 0, 59, "5", nil},
+		{"Random within the field", "~", 0, 59, "5", nil},
+		{"Random from a start", "10~", 0, 59, "15", nil},
+		{"Random up to an end", "~3", 1, 31, "3", nil},
+		{"Random in a list", "1,20~25", 0, 59, "1,25", nil},
+		{"Range out of bounds", "0~60", 0, 59, "", errors.New("invalid random range: 0~60")},
+		{"Range in reverse", "30~0", 0, 59, "", errors.New("invalid random range: 30~0")},
+		{"Not a number", "a~b", 0, 59, "", errors.New("invalid random range: a~b")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			actualOut, actualErr := resolveRandom(test.value, fixedRandom(5), test.min, test.max)
+
+			if test.expErr != nil {
+				assert.EqualError(t, actualErr, test.expErr.Error())
+			} else {
+				assert.Nil(t, actualErr)
+				assert.Equal(t, test.expOut, actualOut)
+			}
+		})
+	}
+}
+
+func TestParseWithRandomSource(t *testing.T) {
+	parse := func() *Schedule {
+		schedule, err := New(WithRandomSource(rand.New(rand.NewSource(42)))).Parse("0~30 ~ * * * /usr/bin/backup")
+		assert.Nil(t, err)
+
+		return schedule
+	}
+
+	first, second := parse(), parse()
+
+	assert.Len(t, first.Values(Minute), 1)
+	assert.LessOrEqual(t, first.Values(Minute)[0], 30)
+	assert.Len(t, first.Values(Hour), 1)
+	assert.Equal(t, first.Values(Minute), second.Values(Minute))
+	assert.Equal(t, first.Values(Hour), second.Values(Hour))
+	assert.Equal(t, "0~30", first.Token(Minute))
+	assert.Equal(t, "~", first.Token(Hour))
+
+	schedule, err := New().ParseExpression("~ * * * *")
+	assert.Nil(t, err)
+	assert.Len(t, schedule.Values(Minute), 1)
+}
+
+func TestParseWithRandomSourceConcurrently(t *testing.T) {
+	cron := New(WithRandomSource(rand.New(rand.NewSource(42))))
+
+	var wg sync.WaitGroup
+
+	// run with -race, *rand.Rand on its own is not safe for concurrent use
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				schedule, err := cron.ParseExpression("~ ~ * * *")
+				assert.Nil(t, err)
+				assert.Len(t, schedule.Values(Minute), 1)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,229 @@
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field identifies one of the time fields of a cron expression.
+type Field int
+
+const (
+	Minute Field = iota
+	Hour
+	DayOfMonth
+	Month
+	DayOfWeek
+	Second
+	Year
+)
+
+func (f Field) String() string {
+	switch f {
+	case Minute:
+		return "minute"
+	case Hour:
+		return "hour"
+	case DayOfMonth:
+		return "day of month"
+	case Month:
+		return "month"
+	case DayOfWeek:
+		return "day of week"
+	case Second:
+		return "second"
+	case Year:
+		return "year"
+	default:
+		return fmt.Sprintf("Field(%d)", int(f))
+	}
+}
+
+// Kind tells what triggers a schedule.
+type Kind int
+
+const (
+	// KindCalendar schedules fire at the times allowed by their fields.
+	KindCalendar Kind = iota
+	// KindReboot schedules (@reboot) fire once when cron starts and have
+	// no time based occurrences.
+	KindReboot
+	// KindInterval schedules (@every) fire at a fixed interval from an
+	// anchor time and have no fields.
+	KindInterval
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindCalendar:
+		return "calendar"
+	case KindReboot:
+		return "reboot"
+	case KindInterval:
+		return "interval"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// noSpecificValue is the token of a day field that leaves the day to the
+// other day field.
+const noSpecificValue = "?"
+
+// Schedule is the result of parsing a cron expression. It is immutable, the
+// accessors hand out copies so callers cannot change a parsed schedule.
+type Schedule struct {
+	kind        Kind
+	macro       string
+	interval    time.Duration
+	anchor      time.Time
+	location    *time.Location
+	fields      []Field
+	tokens      map[Field]string
+	values      map[Field][]int
+	specs       map[Field][]daySpec
+	user        string
+	command     string
+	dayMatching DayMatching
+}
+
+// Kind returns what triggers the schedule.
+func (s *Schedule) Kind() Kind {
+	return s.kind
+}
+
+// Macro returns the macro the schedule was written as, e.g. "@daily", or
+// an empty string if it was written as fields.
+func (s *Schedule) Macro() string {
+	return s.macro
+}
+
+// Interval returns how often a KindInterval schedule fires, and zero for
+// other kinds.
+func (s *Schedule) Interval() time.Duration {
+	return s.interval
+}
+
+// Anchor returns the time a KindInterval schedule is anchored to, and the
+// zero Time for other kinds.
+func (s *Schedule) Anchor() time.Time {
+	return s.anchor
+}
+
+// Location returns the time zone set with a CRON_TZ= or TZ= prefix, or else
+// the Location of the parser's dialect (UTC for AWS), or else nil.
+func (s *Schedule) Location() *time.Location {
+	return s.location
+}
+
+// Fields returns the time fields of the schedule in expression order. It is
+// empty for KindReboot and KindInterval schedules.
+func (s *Schedule) Fields() []Field {
+	return append([]Field(nil), s.fields...)
+}
+
+// hasField reports whether f is one of the time fields of the schedule.
+func (s *Schedule) hasField(f Field) bool {
+	for _, each := range s.fields {
+		if each == f {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Values returns the sorted, de-duplicated values allowed for field f.
+func (s *Schedule) Values(f Field) []int {
+	return append([]int(nil), s.values[f]...)
+}
+
+// Token returns field f as written in the expression, e.g. "0~30" or "H"
+// for a field whose values were picked by the parser.
+func (s *Schedule) Token(f Field) string {
+	return s.tokens[f]
+}
+
+// Specials returns the calendar dependent values of a day field in numeric
+// form, e.g. "L", "15W", "LW", "5L" or "1#2". They are not part of Values as
+// the days they stand for depend on the month.
+func (s *Schedule) Specials(f Field) []string {
+	var result []string
+	for _, spec := range s.specs[f] {
+		result = append(result, spec.String())
+	}
+
+	return result
+}
+
+// Wildcard reports whether field f was written starting with "*", e.g. "*"
+// or "*/2". Like cron(8), such a day field does not take part in the either
+// day rule described by MatchesEitherDay.
+func (s *Schedule) Wildcard(f Field) bool {
+	token, ok := s.tokens[f]
+
+	return ok && strings.HasPrefix(token, "*")
+}
+
+// NoSpecificValue reports whether day field f was written as "?". Unlike
+// "*" such a field has no Values: it places no constraint on the day, which
+// is decided by the other day field alone.
+func (s *Schedule) NoSpecificValue(f Field) bool {
+	return s.tokens[f] == noSpecificValue
+}
+
+// DayMatching returns how the day of month and day of week fields combine.
+func (s *Schedule) DayMatching() DayMatching {
+	return s.dayMatching
+}
+
+// MatchesEitherDay reports whether the schedule fires on days matching
+// either the day of month or the day of week field, rather than both. This
+// is the case with DayMatchVixie when neither day field is a wildcard or ?.
+func (s *Schedule) MatchesEitherDay() bool {
+	return s.dayMatching == DayMatchVixie && s.restricts(DayOfMonth) && s.restricts(DayOfWeek)
+}
+
+// restricts reports whether day field f takes part in the either day rule.
+func (s *Schedule) restricts(f Field) bool {
+	return !s.Wildcard(f) && !s.NoSpecificValue(f)
+}
+
+// User returns the user a system crontab entry runs as, or an empty string
+// for schedules not parsed WithSystemCrontab.
+func (s *Schedule) User() string {
+	return s.user
+}
+
+// Command returns the command that follows the time fields.
+func (s *Schedule) Command() string {
+	return s.command
+}
+
+// toSet converts the expanded values of a field into sorted unique ints.
+func toSet(values []string) ([]int, error) {
+	seen := make(map[int]bool, len(values))
+
+	var result []int
+
+	for _, value := range values {
+		num, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value: %s", value)
+		}
+
+		if seen[num] {
+			continue
+		}
+
+		seen[num] = true
+		result = append(result, num)
+	}
+
+	sort.Ints(result)
+
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package cron
+
+type second struct {
+	min int
+	max int
+}
+
+func newSecond() *second {
+	return &second{
+		min: 0,
+		max: 59,
+	}
+}
+
+func (s *second) Validate() error {
+	return nil
+}
+
+func (s *second) Expand(field string) ([]string, error) {
+	return expand(field, s.min, s.max)
+}
+
+func (s *second) bounds() (int, int) {
+	return s.min, s.max
+}
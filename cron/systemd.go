@@ -0,0 +1,537 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// calendarWeekdays are the systemd names of the weekdays, indexed like the
+// values of the day of week field.
+var calendarWeekdays = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// calendarShorthands are the special OnCalendar expressions of systemd and
+// their normalized form.
+var calendarShorthands = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+}
+
+// calendarDay is one alternative for the days of an OnCalendar expression:
+// the weekdays, empty for any, and the day of the month written with its
+// separator, "-*" for any day or e.g. "~01" for the last one.
+type calendarDay struct {
+	weekdays string
+	day      string
+}
+
+// OnCalendar converts the schedule into systemd OnCalendar= expressions as
+// described in systemd.time(7), e.g. "Mon..Fri *-*-* 09:00:00". A timer
+// fires whenever one of them elapses; several are returned when the
+// schedule fires on either day field or mixes values with L forms in a day
+// field. Schedules that OnCalendar cannot express exactly, such as @reboot,
+// intervals and the W forms, return an error wrapping ErrNotRepresentable.
+func (s *Schedule) OnCalendar() ([]string, error) {
+	if s.kind != KindCalendar {
+		return nil, fmt.Errorf("%w: %s schedules have no OnCalendar form", ErrNotRepresentable, s.kind)
+	}
+
+	days, err := s.calendarDays()
+	if err != nil {
+		return nil, err
+	}
+
+	year := "*"
+	if s.hasField(Year) {
+		year = formatCalendarValues(s.values[Year], 1970, 2199, 4, false)
+	}
+
+	date := year + "-" + formatCalendarValues(s.values[Month], 1, 12, 2, true)
+	clock := formatCalendarValues(s.values[Hour], 0, 23, 2, true) + ":" +
+		formatCalendarValues(s.values[Minute], 0, 59, 2, true) + ":" +
+		formatCalendarValues(s.seconds(), 0, 59, 2, true)
+
+	var lines []string
+
+	for _, day := range days {
+		line := date + day.day + " " + clock
+
+		if day.weekdays != "" {
+			line = day.weekdays + " " + line
+		}
+
+		if s.location != nil && s.location != time.Local {
+			line += " " + s.location.String()
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// calendarDays returns the day alternatives of the schedule, combining the
+// two day fields the way the schedule does.
+func (s *Schedule) calendarDays() ([]calendarDay, error) {
+	monthDays, err := s.calendarMonthDays()
+	if err != nil {
+		return nil, err
+	}
+
+	weekdays := s.calendarWeekdays()
+	anyDay := []calendarDay{{day: "-*"}}
+
+	if s.MatchesEitherDay() {
+		if monthDays == nil || weekdays == nil {
+			return anyDay, nil
+		}
+
+		for _, day := range monthDays {
+			weekdays = append(weekdays, calendarDay{day: day})
+		}
+
+		return fillDays(weekdays), nil
+	}
+
+	switch {
+	case monthDays == nil && weekdays == nil:
+		return anyDay, nil
+	case monthDays == nil:
+		return fillDays(weekdays), nil
+	}
+
+	if weekdays == nil {
+		weekdays = []calendarDay{{}}
+	}
+
+	// both fields have to match, which only works while the day of week
+	// leaves the day of the month alone
+	var days []calendarDay
+
+	for _, weekday := range weekdays {
+		if weekday.day != "" {
+			return nil, fmt.Errorf("%w: OnCalendar cannot combine a day of week such as 5L or 1#2 with a day of month", ErrNotRepresentable)
+		}
+
+		for _, day := range monthDays {
+			days = append(days, calendarDay{weekdays: weekday.weekdays, day: day})
+		}
+	}
+
+	return days, nil
+}
+
+// calendarMonthDays returns the alternatives for the day of month field, or
+// nil if it allows every day.
+func (s *Schedule) calendarMonthDays() ([]string, error) {
+	values := s.values[DayOfMonth]
+	if s.NoSpecificValue(DayOfMonth) || len(values) == 31 {
+		return nil, nil
+	}
+
+	var days []string
+	if len(values) > 0 {
+		days = append(days, "-"+formatCalendarValues(values, 1, 31, 2, true))
+	}
+
+	for _, spec := range s.specs[DayOfMonth] {
+		if spec.kind != lastDayOfMonth {
+			return nil, fmt.Errorf("%w: OnCalendar has no equivalent of %s", ErrNotRepresentable, spec)
+		}
+
+		days = append(days, fmt.Sprintf("~%02d", spec.day+1))
+	}
+
+	return days, nil
+}
+
+// calendarWeekdays returns the alternatives for the day of week field, or
+// nil if it allows every day. The nL and n#k forms also restrict the day of
+// the month.
+func (s *Schedule) calendarWeekdays() []calendarDay {
+	values := s.values[DayOfWeek]
+	if s.NoSpecificValue(DayOfWeek) || len(values) == 7 {
+		return nil
+	}
+
+	var days []calendarDay
+	if len(values) > 0 {
+		days = append(days, calendarDay{weekdays: formatCalendarWeekdays(values)})
+	}
+
+	for _, spec := range s.specs[DayOfWeek] {
+		name := calendarWeekdays[spec.weekday]
+
+		if spec.kind == lastDayOfWeek {
+			// the last seven days of the month, as in "Fri *-*~07/1"
+			days = append(days, calendarDay{weekdays: name, day: "~07/1"})
+			continue
+		}
+
+		first := (spec.nth-1)*7 + 1
+		days = append(days, calendarDay{weekdays: name, day: fmt.Sprintf("-%02d..%02d", first, minInt(first+6, 31))})
+	}
+
+	return days
+}
+
+// fillDays sets the day of the alternatives that only restrict the weekday
+// to any day of the month.
+func fillDays(days []calendarDay) []calendarDay {
+	for i := range days {
+		if days[i].day == "" {
+			days[i].day = "-*"
+		}
+	}
+
+	return days
+}
+
+// formatCalendarValues writes the values of a field in OnCalendar syntax:
+// "*" for all of them, "00/15" for a repetition that runs to max when steps
+// is set, and otherwise a list of values and ranges such as "01,10..15".
+func formatCalendarValues(values []int, min, max, width int, steps bool) string {
+	if len(values) == max-min+1 {
+		return "*"
+	}
+
+	pad := func(value int) string {
+		return fmt.Sprintf("%0*d", width, value)
+	}
+
+	if step, ok := repetition(values, max); steps && ok {
+		return pad(values[0]) + "/" + strconv.Itoa(step)
+	}
+
+	var parts []string
+
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+
+		if j-i >= 2 {
+			parts = append(parts, pad(values[i])+".."+pad(values[j]))
+		} else {
+			for _, value := range values[i : j+1] {
+				parts = append(parts, pad(value))
+			}
+		}
+
+		i = j + 1
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// repetition reports the step of values that start anywhere and repeat
+// until max, such as 5,20,35,50 in the minute field.
+func repetition(values []int, max int) (int, bool) {
+	if len(values) < 3 {
+		return 0, false
+	}
+
+	step := values[1] - values[0]
+	if step < 2 || values[len(values)-1]+step <= max {
+		return 0, false
+	}
+
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return 0, false
+		}
+	}
+
+	return step, true
+}
+
+// formatCalendarWeekdays writes weekdays (0 being Sunday) the way systemd
+// does, starting the week on Monday, e.g. "Mon..Fri" or "Sat,Sun".
+func formatCalendarWeekdays(values []int) string {
+	var week []int
+	for _, weekday := range []int{1, 2, 3, 4, 5, 6, 0} {
+		if contains(values, weekday) {
+			week = append(week, weekday)
+		}
+	}
+
+	var parts []string
+
+	for i := 0; i < len(week); {
+		j := i
+		for j+1 < len(week) && week[j+1] == (week[j]+1)%7 {
+			j++
+		}
+
+		if j-i >= 2 {
+			parts = append(parts, calendarWeekdays[week[i]]+".."+calendarWeekdays[week[j]])
+		} else {
+			for _, weekday := range week[i : j+1] {
+				parts = append(parts, calendarWeekdays[weekday])
+			}
+		}
+
+		i = j + 1
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// ParseOnCalendar parses a systemd OnCalendar= expression such as
+// "Mon..Fri *-*-* 09:00" or "daily" into a schedule with seconds and year
+// fields. Both day fields have to match, as in systemd, and a trailing time
+// zone becomes the Location of the schedule. Forms without a cron
+// equivalent, such as fractional seconds, return an error wrapping
+// ErrInvalidExpression.
+func ParseOnCalendar(spec string) (*Schedule, error) {
+	expr, loc, err := calendarToCron(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid OnCalendar expression %q: %s", ErrInvalidExpression, spec, err)
+	}
+
+	schedule, err := New(WithSeconds(), WithYear(), WithDayMatching(DayMatchAnd)).ParseExpression(expr)
+	if err != nil {
+		return nil, fmt.Errorf("error in parsing OnCalendar expression: %s, err: %w", spec, err)
+	}
+
+	schedule.location = loc
+
+	return schedule, nil
+}
+
+// calendarToCron rewrites an OnCalendar expression into a seven field cron
+// expression and the time zone it names, if any.
+func calendarToCron(spec string) (string, *time.Location, error) {
+	tokens := strings.Fields(spec)
+	if len(tokens) == 0 {
+		return "", nil, fmt.Errorf("empty expression")
+	}
+
+	if normalized, ok := calendarShorthands[strings.ToLower(tokens[0])]; ok {
+		tokens = append(strings.Fields(normalized), tokens[1:]...)
+	}
+
+	var loc *time.Location
+
+	if last := tokens[len(tokens)-1]; len(tokens) > 1 && hasLetter(last) {
+		zone, err := time.LoadLocation(last)
+		if err != nil {
+			return "", nil, fmt.Errorf("unknown time zone %s", last)
+		}
+
+		loc, tokens = zone, tokens[:len(tokens)-1]
+	}
+
+	weekdays, date, clock := "*", "*-*-*", "00:00:00"
+
+	if hasLetter(tokens[0]) {
+		weekdays, tokens = tokens[0], tokens[1:]
+	}
+
+	if len(tokens) > 0 && !strings.Contains(tokens[0], ":") {
+		date, tokens = tokens[0], tokens[1:]
+	}
+
+	if len(tokens) > 0 && strings.Contains(tokens[0], ":") {
+		clock, tokens = tokens[0], tokens[1:]
+	}
+
+	if len(tokens) > 0 {
+		return "", nil, fmt.Errorf("unexpected %s", tokens[0])
+	}
+
+	dayOfWeek, err := calendarWeekdaysToCron(weekdays)
+	if err != nil {
+		return "", nil, err
+	}
+
+	year, month, dayOfMonth, err := calendarDateToCron(date)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// the last weekday of the month, as in "Fri *-*~07/1"
+	if dayOfMonth == "~07/1" {
+		if !isSingleWeekday(dayOfWeek) {
+			return "", nil, fmt.Errorf("~07/1 is only supported with a single weekday")
+		}
+
+		dayOfMonth, dayOfWeek = noSpecificValue, dayOfWeek+"L"
+	}
+
+	if strings.HasPrefix(dayOfMonth, "~") {
+		return "", nil, fmt.Errorf("unsupported day %s", dayOfMonth)
+	}
+
+	hour, minute, second, err := calendarClockToCron(clock)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return strings.Join([]string{second, minute, hour, dayOfMonth, month, dayOfWeek, year}, " "), loc, nil
+}
+
+// calendarDateToCron splits a date such as "*-*-01,15", "2027-02~03" or
+// "12-24" into cron fields. Days counted from the end of the month with ~
+// become L forms where cron has one, other ~ days are returned as they are.
+func calendarDateToCron(date string) (string, string, string, error) {
+	end := strings.LastIndexAny(date, "-~")
+	if end < 0 {
+		return "", "", "", fmt.Errorf("invalid date %s", date)
+	}
+
+	head, day := date[:end], date[end+1:]
+
+	year, month, found := strings.Cut(head, "-")
+	if !found {
+		year, month = "*", head
+	}
+
+	fields := []*string{&year, &month}
+	if date[end] == '-' {
+		fields = append(fields, &day)
+	}
+
+	for _, field := range fields {
+		converted, err := calendarValuesToCron(*field)
+		if err != nil {
+			return "", "", "", err
+		}
+
+		*field = converted
+	}
+
+	if date[end] == '~' {
+		last, err := strconv.Atoi(day)
+
+		switch {
+		case day == "07/1":
+			day = "~07/1"
+		case err != nil || last < 1 || last > 31:
+			return "", "", "", fmt.Errorf("invalid day ~%s", day)
+		case last == 1:
+			day = "L"
+		default:
+			day = fmt.Sprintf("L-%d", last-1)
+		}
+	}
+
+	return year, month, day, nil
+}
+
+// calendarClockToCron splits a time such as "09:00" or "*:0/15:30" into the
+// hour, minute and second fields.
+func calendarClockToCron(clock string) (string, string, string, error) {
+	parts := strings.Split(clock, ":")
+	if len(parts) == 2 {
+		parts = append(parts, "00")
+	}
+
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid time %s", clock)
+	}
+
+	if strings.Contains(strings.ReplaceAll(parts[2], "..", ""), ".") {
+		return "", "", "", fmt.Errorf("fractional seconds are not supported")
+	}
+
+	for i, part := range parts {
+		converted, err := calendarValuesToCron(part)
+		if err != nil {
+			return "", "", "", err
+		}
+
+		parts[i] = converted
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// calendarValuesToCron rewrites the values of a date or time component into
+// cron syntax, which only differs in writing ranges as "a..b".
+func calendarValuesToCron(values string) (string, error) {
+	if values == "" || strings.Trim(values, "0123456789*.,/") != "" {
+		return "", fmt.Errorf("invalid value %s", values)
+	}
+
+	return strings.ReplaceAll(values, "..", "-"), nil
+}
+
+// calendarWeekdaysToCron rewrites weekdays such as "Mon..Fri,Sun" or
+// "monday" into the day of week field.
+func calendarWeekdaysToCron(weekdays string) (string, error) {
+	if weekdays == "*" {
+		return weekdays, nil
+	}
+
+	elements := strings.Split(weekdays, ",")
+
+	for i, element := range elements {
+		start, end, isRange := strings.Cut(element, "..")
+
+		first, ok := calendarWeekday(start)
+		if !ok {
+			return "", fmt.Errorf("invalid weekday %s", start)
+		}
+
+		elements[i] = first
+
+		if isRange {
+			last, ok := calendarWeekday(end)
+			if !ok {
+				return "", fmt.Errorf("invalid weekday %s", end)
+			}
+
+			// systemd weeks run from Monday, so a range ending on Sunday
+			// ends on cron's 7 rather than wrapping around to 0
+			if last == "SUN" && first != "SUN" {
+				last = "7"
+			}
+
+			elements[i] += "-" + last
+		}
+	}
+
+	return strings.Join(elements, ","), nil
+}
+
+// calendarWeekday returns the cron name of an abbreviated or full English
+// weekday name, ignoring case.
+func calendarWeekday(name string) (string, bool) {
+	for i, weekday := range calendarWeekdays {
+		if strings.EqualFold(name, weekday) || strings.EqualFold(name, time.Weekday(i).String()) {
+			return strings.ToUpper(weekday), true
+		}
+	}
+
+	return "", false
+}
+
+func isSingleWeekday(dayOfWeek string) bool {
+	_, ok := weekdayNames[dayOfWeek]
+
+	return ok
+}
+
+func hasLetter(value string) bool {
+	return strings.IndexFunc(value, func(r rune) bool {
+		return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+	}) >= 0
+}
@@ -0,0 +1,164 @@
+package cron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOnCalendar(t *testing.T) {
+	tests := []struct {
+		msg      string
+		cron     *Parser
+		input    string
+		expLines []string
+	}{
+		{"every minute", New(), "* * * * *", []string{"*-*-* *:*:00"}},
+		{"weekdays", New(), "0 9 * * 1-5", []string{"Mon..Fri *-*-* 09:00:00"}},
+		{"weekend", New(), "30 8 * * 0,6", []string{"Sat,Sun *-*-* 08:30:00"}},
+		{"steps", New(), "*/15 */6 * * *", []string{"*-*-* 00/6:00/15:00"}},
+		{"steps with offset", New(), "5/20 * * * *", []string{"*-*-* *:05/20:00"}},
+		{"lists and ranges", New(), "0,30 9-17 1,15 1-3 *", []string{"*-01..03-01,15 09..17:00,30:00"}},
+		{"macro", New(), "@monthly", []string{"*-*-01 00:00:00"}},
+		{"seconds and year", New(WithSeconds(), WithYear()), "30 0 12 24 12 * 2027", []string{"2027-12-24 12:00:30"}},
+		{"last day of the month", New(), "0 0 L * *", []string{"*-*~01 00:00:00"}},
+		{"days before the last day", New(), "0 0 L-2 * *", []string{"*-*~03 00:00:00"}},
+		{"last friday", New(), "0 18 * * 5L", []string{"Fri *-*~07/1 18:00:00"}},
+		{"second monday", New(), "0 8 * * 1#2", []string{"Mon *-*-08..14 08:00:00"}},
+		{"fifth sunday", New(), "0 8 * * 0#5", []string{"Sun *-*-29..31 08:00:00"}},
+		{"either day", New(), "0 0 13 * 5", []string{"Fri *-*-* 00:00:00", "*-*-13 00:00:00"}},
+		{"both days", New(WithDayMatching(DayMatchAnd)), "0 0 13 * 5", []string{"Fri *-*-13 00:00:00"}},
+		{"no specific day of week", New(), "0 0 13 * ?", []string{"*-*-13 00:00:00"}},
+		{"day of month values and last day", New(), "0 0 1,L * *", []string{"*-*-01 00:00:00", "*-*~01 00:00:00"}},
+		{"time zone", New(), "CRON_TZ=Europe/Berlin 0 9 * * *", []string{"*-*-* 09:00:00 Europe/Berlin"}},
+		{"aws", New(WithDialect(AWS)), "cron(0 12 ? * MON-FRI *)", []string{"Mon..Fri *-*-* 12:00:00 UTC"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := test.cron.Parse(test.input + " /bin/true")
+			assert.Nil(t, err)
+
+			lines, err := schedule.OnCalendar()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expLines, lines)
+		})
+	}
+}
+
+func TestOnCalendarErrors(t *testing.T) {
+	tests := []struct {
+		msg      string
+		cron     *Parser
+		input    string
+		expError string
+	}{
+		{"reboot", New(), "@reboot", "schedule cannot be represented exactly: reboot schedules have no OnCalendar form"},
+		{"interval", New(), "@every 90m", "schedule cannot be represented exactly: interval schedules have no OnCalendar form"},
+		{"nearest weekday", New(), "0 0 15W * *", "schedule cannot be represented exactly: OnCalendar has no equivalent of 15W"},
+		{"last weekday", New(), "0 0 LW * *", "schedule cannot be represented exactly: OnCalendar has no equivalent of LW"},
+		{"nth weekday and day of month", New(WithDayMatching(DayMatchAnd)), "0 0 1-7 * 1#2", "schedule cannot be represented exactly: OnCalendar cannot combine a day of week such as 5L or 1#2 with a day of month"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := test.cron.ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			_, err = schedule.OnCalendar()
+
+			assert.ErrorIs(t, err, ErrNotRepresentable)
+			assert.EqualError(t, err, test.expError)
+		})
+	}
+}
+
+func TestParseOnCalendar(t *testing.T) {
+	from := mustParseTime(t, "2026-10-17T00:00:00Z")
+
+	tests := []struct {
+		input    string
+		expTimes []string
+	}{
+		{"daily", []string{"2026-10-18T00:00:00Z", "2026-10-19T00:00:00Z"}},
+		{"weekly", []string{"2026-10-19T00:00:00Z", "2026-10-26T00:00:00Z"}},
+		{"quarterly", []string{"2027-01-01T00:00:00Z", "2027-04-01T00:00:00Z"}},
+		{"Mon..Fri *-*-* 09:00", []string{"2026-10-19T09:00:00Z", "2026-10-20T09:00:00Z"}},
+		{"saturday,sunday 10:30", []string{"2026-10-17T10:30:00Z", "2026-10-18T10:30:00Z"}},
+		{"Sat..Sun 10:30", []string{"2026-10-17T10:30:00Z", "2026-10-18T10:30:00Z"}},
+		{"*:0/20", []string{"2026-10-17T00:20:00Z", "2026-10-17T00:40:00Z"}},
+		{"*-*-* *:*:15,45", []string{"2026-10-17T00:00:15Z", "2026-10-17T00:00:45Z"}},
+		{"*:*:00..30", []string{"2026-10-17T00:00:01Z", "2026-10-17T00:00:02Z"}},
+		{"12-24 18:00", []string{"2026-12-24T18:00:00Z", "2027-12-24T18:00:00Z"}},
+		{"2027-02..03-01 06:00:00", []string{"2027-02-01T06:00:00Z", "2027-03-01T06:00:00Z"}},
+		{"*-*~01", []string{"2026-10-31T00:00:00Z", "2026-11-30T00:00:00Z"}},
+		{"*-02~03 12:00", []string{"2027-02-26T12:00:00Z", "2028-02-27T12:00:00Z"}},
+		{"Fri *-*~07/1 18:00", []string{"2026-10-30T18:00:00Z", "2026-11-27T18:00:00Z"}},
+		{"Mon *-*-08..14 08:00", []string{"2026-11-09T08:00:00Z", "2026-12-14T08:00:00Z"}},
+		{"Fri *-*-13", []string{"2026-11-13T00:00:00Z", "2027-08-13T00:00:00Z"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			schedule, err := ParseOnCalendar(test.input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expTimes, formatTimes(schedule.NextN(from, 2)))
+		})
+	}
+}
+
+func TestParseOnCalendarTimeZone(t *testing.T) {
+	schedule, err := ParseOnCalendar("*-*-* 09:00:00 Europe/Berlin")
+	assert.Nil(t, err)
+	assert.Equal(t, "Europe/Berlin", schedule.Location().String())
+
+	schedule, err = ParseOnCalendar("hourly UTC")
+	assert.Nil(t, err)
+	assert.Equal(t, time.UTC, schedule.Location())
+	assert.Equal(t, []int{0}, schedule.Values(Minute))
+}
+
+func TestParseOnCalendarErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expError string
+	}{
+		{"", `invalid cron expression: invalid OnCalendar expression "": empty expression`},
+		{"*-*-* 09:00 Mars/Olympus", `invalid cron expression: invalid OnCalendar expression "*-*-* 09:00 Mars/Olympus": unknown time zone Mars/Olympus`},
+		{"Someday 09:00", `invalid cron expression: invalid OnCalendar expression "Someday 09:00": invalid weekday Someday`},
+		{"*-*-* 09:00:00.5", `invalid cron expression: invalid OnCalendar expression "*-*-* 09:00:00.5": fractional seconds are not supported`},
+		{"Mon..Fri *-*~07/1", `invalid cron expression: invalid OnCalendar expression "Mon..Fri *-*~07/1": ~07/1 is only supported with a single weekday`},
+		{"*-*~03/2", `invalid cron expression: invalid OnCalendar expression "*-*~03/2": invalid day ~03/2`},
+		{"09:00 *-*-*", `invalid cron expression: invalid OnCalendar expression "09:00 *-*-*": unexpected *-*-*`},
+		{"*-*-32", "error in parsing OnCalendar expression: *-*-32, err: error in expanding cron expression: 00 00 00 32 * * *, err: error in parsing day of month. err: invalid value: 32"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			_, err := ParseOnCalendar(test.input)
+
+			assert.EqualError(t, err, test.expError)
+		})
+	}
+}
+
+func TestOnCalendarRoundTrip(t *testing.T) {
+	from := mustParseTime(t, "2026-10-17T00:00:00Z")
+
+	for _, input := range []string{"0 9 * * 1-5", "*/15 */6 1,15 * *", "0 0 L * ?", "0 18 * * 5L", "0 8 * * 1#2", "0 10 * * 5-7", "0 10 * * 6,0", "0 10 * * 0-6"} {
+		t.Run(input, func(t *testing.T) {
+			schedule, err := New().ParseExpression(input)
+			assert.Nil(t, err)
+
+			lines, err := schedule.OnCalendar()
+			assert.Nil(t, err)
+			assert.Len(t, lines, 1)
+
+			converted, err := ParseOnCalendar(lines[0])
+			assert.Nil(t, err)
+			assert.Equal(t, formatTimes(schedule.NextN(from, 5)), formatTimes(converted.NextN(from, 5)))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// aliasedField is implemented by the fields that accept names for their
+// values, such as JAN or MON.
+type aliasedField interface {
+	aliases() map[string]int
+}
+
+// wrapRanges rewrites the ranges of a field whose start is after their end,
+// such as "22-2" or "50-10/5", into the list of values they cover when
+// counting past the upper bound back to the lower one, e.g. "22,23,0,1,2".
+// All other elements are returned unchanged.
+func wrapRanges(value string, min, max int) (string, error) {
+	elements := strings.Split(value, ",")
+
+	for i, element := range elements {
+		rangePart, stepPart, hasStep := strings.Cut(element, "/")
+
+		startPart, endPart, isRange := strings.Cut(rangePart, "-")
+		if !isRange {
+			continue
+		}
+
+		start, err1 := strconv.Atoi(startPart)
+		end, err2 := strconv.Atoi(endPart)
+		if err1 != nil || err2 != nil || start <= end {
+			continue
+		}
+
+		if start < min || start > max || end < min || end > max {
+			return "", fmt.Errorf("invalid range values: %s", element)
+		}
+
+		step := 1
+		if hasStep {
+			step, err1 = strconv.Atoi(stepPart)
+			if err1 != nil || step <= 0 {
+				return "", fmt.Errorf("invalid interval value: %s", element)
+			}
+		}
+
+		span := max - min + 1
+		length := end - start + span + 1
+
+		var values []string
+		for offset := 0; offset < length; offset += step {
+			values = append(values, strconv.Itoa((start-min+offset)%span+min))
+		}
+
+		elements[i] = strings.Join(values, ",")
+	}
+
+	return strings.Join(elements, ","), nil
+}
+
+// wrapSunday rewrites the wrapping ranges of a day of week field that start
+// on min+7, the second name of Sunday where max allows it, to start on min
+// instead, so that "7-1" covers the same days as "SUN-MON".
+func wrapSunday(value string, min, max int) string {
+	elements := strings.Split(value, ",")
+
+	for i, element := range elements {
+		startPart, rest, isRange := strings.Cut(element, "-")
+		if !isRange {
+			continue
+		}
+
+		start, err1 := strconv.Atoi(startPart)
+		end, err2 := strconv.Atoi(strings.SplitN(rest, "/", 2)[0])
+		if err1 != nil || err2 != nil || start != min+7 || start > max || end >= start {
+			continue
+		}
+
+		elements[i] = strconv.Itoa(min) + "-" + rest
+	}
+
+	return strings.Join(elements, ",")
+}
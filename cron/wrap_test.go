@@ -0,0 +1,88 @@
+package cron
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWrapRanges(t *testing.T) {
+	tests := []struct {
+		msg    string
+		value  string
+		min    int
+		max    int
+		expOut string
+		expErr error
+	}{
+		{"Range in order is unchanged", "1-5", 0, 23, "1-5", nil},
+		{"Wildcard is unchanged", "*/2", 0, 23, "*/2", nil},
+		{"Hours past midnight", "22-2", 0, 23, "22,23,0,1,2", nil},
+		{"Minutes with step", "50-10/5", 0, 59, "50,55,0,5,10", nil},
+		{"Step not aligned with the bound", "55-5/3", 0, 59, "55,58,1,4", nil},
+		{"Months starting at 1", "11-2", 1, 12, "11,12,1,2", nil},
+		{"Wrapping range in a list", "1,22-1,12", 0, 23, "1,22,23,0,1,12", nil},
+		{"Start out of bounds", "25-2", 0, 23, "", errors.New("invalid range values: 25-2")},
+		{"Invalid step", "22-2/0", 0, 23, "", errors.New("invalid interval value: 22-2/0")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			actualOut, actualErr := wrapRanges(test.value, test.min, test.max)
+
+			if test.expErr != nil {
+				assert.EqualError(t, actualErr, test.expErr.Error())
+			} else {
+				assert.Nil(t, actualErr)
+				assert.Equal(t, test.expOut, actualOut)
+			}
+		})
+	}
+}
+
+func TestParseWithWrapAround(t *testing.T) {
+	schedule, err := New(WithWrapAround()).Parse("50-10/5 22-2 * NOV-FEB FRI-MON /usr/bin/batch")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []int{0, 5, 10, 50, 55}, schedule.Values(Minute))
+	assert.Equal(t, []int{0, 1, 2, 22, 23}, schedule.Values(Hour))
+	assert.Equal(t, []int{1, 2, 11, 12}, schedule.Values(Month))
+	assert.Equal(t, []int{0, 1, 5, 6}, schedule.Values(DayOfWeek))
+
+	schedule, err = New(WithWrapAround()).Parse("0 0 * * 7-1 /usr/bin/batch")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1}, schedule.Values(DayOfWeek))
+
+	schedule, err = New(WithWrapAround()).Parse("0 0 * * 5-7 /usr/bin/batch")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 5, 6}, schedule.Values(DayOfWeek))
+
+	_, err = New(WithWrapAround(), WithDialect(Quartz)).Parse("0 0 0 ? * 8-2 /usr/bin/batch")
+	assert.ErrorContains(t, err, "invalid range values: 8-2")
+
+	_, err = New().Parse("0 22-2 * * * /usr/bin/batch")
+	assert.ErrorContains(t, err, "error in parsing hour. err: invalid range field: 22-2")
+}
+
+func TestWrapSunday(t *testing.T) {
+	tests := []struct {
+		msg    string
+		value  string
+		min    int
+		max    int
+		expOut string
+	}{
+		{"Sunday as 7 starting a wrapping range", "7-1", 0, 7, "0-1"},
+		{"With step", "7-3/2", 0, 7, "0-3/2"},
+		{"In a list", "3,7-2", 0, 7, "3,0-2"},
+		{"Range ending on 7 is unchanged", "5-7", 0, 7, "5-7"},
+		{"Range in order is unchanged", "1-5", 0, 7, "1-5"},
+		{"No second name of Sunday", "8-2", 1, 7, "8-2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			assert.Equal(t, test.expOut, wrapSunday(test.value, test.min, test.max))
+		})
+	}
+}
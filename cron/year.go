@@ -0,0 +1,25 @@
+package cron
+
+type year struct {
+	min int
+	max int
+}
+
+func newYear() *year {
+	return &year{
+		min: 1970,
+		max: 2199,
+	}
+}
+
+func (y *year) Validate() error {
+	return nil
+}
+
+func (y *year) Expand(field string) ([]string, error) {
+	return expand(field, y.min, y.max)
+}
+
+func (y *year) bounds() (int, int) {
+	return y.min, y.max
+}
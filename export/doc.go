@@ -0,0 +1,15 @@
+// Package export converts parsed crontab entries into the job definitions
+// of other schedulers, so that hosts can be migrated away from cron
+// mechanically:
+//
+//	crontab, err := cron.New().ParseCrontab(file)
+//	if err != nil {
+//		// handle the error
+//	}
+//	units, err := export.SystemdCrontab("alice", "alice", crontab)
+//	manifests, err := export.KubernetesCrontab("alice", crontab, export.KubernetesOptions{Image: "busybox:1.36"})
+//
+// Entries are only converted when the target fires at exactly the same
+// times and runs the same command. Anything else is refused with an error
+// wrapping cron.ErrNotRepresentable, rather than converted approximately.
+package export
@@ -0,0 +1,445 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cronparser/cron"
+	"gopkg.in/yaml.v3"
+)
+
+// maxCronJobName is the longest name Kubernetes accepts for a CronJob, it
+// leaves room for the suffix of the Jobs it creates.
+const maxCronJobName = 52
+
+// referenceEscaper keeps the kubelet from expanding $(NAME) references in
+// the command, args and env of a container, where it also turns $$ into $.
+var referenceEscaper = strings.NewReplacer("$$", "$$$$", "$(", "$$(")
+
+// KubernetesOptions configure the CronJob manifests written by Kubernetes.
+type KubernetesOptions struct {
+	// Image is the container image the command runs in, it is required.
+	Image string
+	// Namespace is the namespace of the CronJobs, empty for the namespace
+	// of the kubectl context.
+	Namespace string
+}
+
+// cronJob is the subset of a batch/v1 CronJob that Kubernetes writes.
+type cronJob struct {
+	APIVersion string      `yaml:"apiVersion"`
+	Kind       string      `yaml:"kind"`
+	Metadata   objectMeta  `yaml:"metadata"`
+	Spec       cronJobSpec `yaml:"spec"`
+}
+
+type objectMeta struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+type cronJobSpec struct {
+	Schedule    string      `yaml:"schedule"`
+	TimeZone    string      `yaml:"timeZone,omitempty"`
+	JobTemplate jobTemplate `yaml:"jobTemplate"`
+}
+
+type jobTemplate struct {
+	Spec struct {
+		Template struct {
+			Spec podSpec `yaml:"spec"`
+		} `yaml:"template"`
+	} `yaml:"spec"`
+}
+
+type podSpec struct {
+	Containers    []container `yaml:"containers"`
+	RestartPolicy string      `yaml:"restartPolicy"`
+}
+
+type container struct {
+	Name    string   `yaml:"name"`
+	Image   string   `yaml:"image"`
+	Command []string `yaml:"command"`
+	Args    []string `yaml:"args,omitempty"`
+	Env     []envVar `yaml:"env,omitempty"`
+}
+
+type envVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// Kubernetes converts a crontab entry into a batch/v1 CronJob manifest
+// called name. The schedule is written as a five field expression in
+// spec.schedule and its time zone as spec.timeZone. The command is split
+// into the command and args of the container like a shell would, or run
+// with SHELL -c if it uses pipes, redirections, variables or other shell
+// syntax. The environment of the entry, except CRON_TZ, becomes the env of
+// the container. $( and $$ are escaped so that the kubelet does not expand
+// them as references to environment variables.
+//
+// Kubernetes only understands the standard five fields, so a seconds field
+// other than 0, a year field other than *, L, W, #, @reboot and @every are
+// refused with an error wrapping cron.ErrNotRepresentable that names the
+// offending syntax. So are schedules that need both day fields to match,
+// system crontab users and commands that pass standard input with %.
+func Kubernetes(name string, entry cron.Entry, opts KubernetesOptions) ([]byte, error) {
+	job, err := newCronJob(name, entry, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeYAML(job)
+}
+
+// KubernetesCrontab converts every entry of a crontab with Kubernetes into
+// a multi-document YAML stream, naming the CronJobs after prefix and the
+// line number of the entry, e.g. "alice-12". Entries that cannot be
+// converted are reported together as a *cron.CrontabError, alongside the
+// manifests of the others.
+func KubernetesCrontab(prefix string, crontab *cron.Crontab, opts KubernetesOptions) ([]byte, error) {
+	var (
+		jobs    []interface{}
+		invalid []*cron.LineError
+	)
+
+	for _, entry := range crontab.Entries {
+		job, err := newCronJob(fmt.Sprintf("%s-%d", prefix, entry.Line), entry, opts)
+		if err != nil {
+			invalid = append(invalid, &cron.LineError{Line: entry.Line, Err: err})
+			continue
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	manifests, err := encodeYAML(jobs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invalid) > 0 {
+		return manifests, &cron.CrontabError{Errors: invalid}
+	}
+
+	return manifests, nil
+}
+
+func newCronJob(name string, entry cron.Entry, opts KubernetesOptions) (*cronJob, error) {
+	if !isResourceName(name) {
+		return nil, fmt.Errorf("invalid CronJob name %q, expected at most %d lowercase letters, digits and -", name, maxCronJobName)
+	}
+
+	if opts.Image == "" {
+		return nil, errors.New("a container image is required")
+	}
+
+	schedule := entry.Schedule
+
+	expr, err := kubernetesSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.User() != "" {
+		return nil, fmt.Errorf("%w: Kubernetes runs the command as the user of the image, not %s", cron.ErrNotRepresentable, schedule.User())
+	}
+
+	command, err := cronCommand(schedule.Command())
+	if err != nil {
+		return nil, err
+	}
+
+	args, ok := splitCommand(command)
+	if !ok {
+		shell := defaultShell
+		if entry.Env["SHELL"] != "" {
+			shell = entry.Env["SHELL"]
+		}
+
+		args = []string{shell, "-c", command}
+	}
+
+	for i, arg := range args {
+		args[i] = referenceEscaper.Replace(arg)
+	}
+
+	job := &cronJob{
+		APIVersion: "batch/v1",
+		Kind:       "CronJob",
+		Metadata:   objectMeta{Name: name, Namespace: opts.Namespace},
+		Spec:       cronJobSpec{Schedule: expr},
+	}
+
+	if loc := schedule.Location(); loc != nil && loc.String() != "Local" {
+		job.Spec.TimeZone = loc.String()
+	}
+
+	var env []envVar
+	for _, assignment := range environment(entry.Env) {
+		name, value, _ := strings.Cut(assignment, "=")
+		env = append(env, envVar{Name: name, Value: referenceEscaper.Replace(value)})
+	}
+
+	job.Spec.JobTemplate.Spec.Template.Spec = podSpec{
+		Containers: []container{{
+			Name:    "job",
+			Image:   opts.Image,
+			Command: args[:1],
+			Args:    args[1:],
+			Env:     env,
+		}},
+		RestartPolicy: "OnFailure",
+	}
+
+	return job, nil
+}
+
+// kubernetesSchedule writes the schedule as the five field expression of
+// spec.schedule.
+func kubernetesSchedule(schedule *cron.Schedule) (string, error) {
+	switch schedule.Kind() {
+	case cron.KindReboot:
+		return "", fmt.Errorf("%w: @reboot is not supported by Kubernetes", cron.ErrNotRepresentable)
+	case cron.KindInterval:
+		return "", fmt.Errorf("%w: @every and rate() are not supported by Kubernetes", cron.ErrNotRepresentable)
+	}
+
+	for _, field := range schedule.Fields() {
+		switch {
+		case field == cron.Second && !equalInts(schedule.Values(field), []int{0}):
+			return "", fmt.Errorf("%w: Kubernetes schedules have no seconds field, got %s", cron.ErrNotRepresentable, schedule.Token(field))
+		case field == cron.Year && schedule.Token(field) != "*":
+			return "", fmt.Errorf("%w: Kubernetes schedules have no year field, got %s", cron.ErrNotRepresentable, schedule.Token(field))
+		}
+
+		if specials := schedule.Specials(field); len(specials) > 0 {
+			return "", fmt.Errorf("%w: %s in the %s field is not supported by Kubernetes", cron.ErrNotRepresentable, specials[0], field)
+		}
+	}
+
+	dayOfMonth, dayOfWeek, err := kubernetesDays(schedule)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		formatCronValues(schedule.Values(cron.Minute), 0, 59),
+		formatCronValues(schedule.Values(cron.Hour), 0, 23),
+		dayOfMonth,
+		formatCronValues(schedule.Values(cron.Month), 1, 12),
+		dayOfWeek,
+	}, " "), nil
+}
+
+// kubernetesDays writes the day fields so that Kubernetes combines them the
+// way the schedule does. Like Vixie cron, Kubernetes fires when either day
+// field matches unless one of them is *, in which case only the other one
+// counts.
+func kubernetesDays(schedule *cron.Schedule) (string, string, error) {
+	dayOfMonth, dayOfWeek := schedule.Values(cron.DayOfMonth), schedule.Values(cron.DayOfWeek)
+
+	everyDayOfMonth := schedule.NoSpecificValue(cron.DayOfMonth) || len(dayOfMonth) == 31
+	everyDayOfWeek := schedule.NoSpecificValue(cron.DayOfWeek) || len(dayOfWeek) == 7
+
+	switch {
+	case everyDayOfMonth && everyDayOfWeek:
+		return "*", "*", nil
+	case schedule.MatchesEitherDay():
+		if everyDayOfMonth || everyDayOfWeek {
+			return "*", "*", nil
+		}
+
+		return formatCronValues(dayOfMonth, 1, 31), formatCronValues(dayOfWeek, 0, 6), nil
+	case everyDayOfMonth:
+		return "*", formatCronValues(dayOfWeek, 0, 6), nil
+	case everyDayOfWeek:
+		return formatCronValues(dayOfMonth, 1, 31), "*", nil
+	}
+
+	return "", "", fmt.Errorf("%w: Kubernetes fires when either day field matches, the schedule needs both", cron.ErrNotRepresentable)
+}
+
+// formatCronValues writes the values of a field in standard cron syntax:
+// "*" for all of them, "*/15" or "5-50/15" for a repetition, and otherwise
+// a list of values and ranges such as "1,10-15".
+func formatCronValues(values []int, min, max int) string {
+	if len(values) == max-min+1 {
+		return "*"
+	}
+
+	if step, ok := cronStep(values); ok {
+		first, last := values[0], values[len(values)-1]
+		if first == min && last+step > max {
+			return "*/" + strconv.Itoa(step)
+		}
+
+		return fmt.Sprintf("%d-%d/%d", first, last, step)
+	}
+
+	var parts []string
+
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+
+		if j-i >= 2 {
+			parts = append(parts, fmt.Sprintf("%d-%d", values[i], values[j]))
+		} else {
+			for _, value := range values[i : j+1] {
+				parts = append(parts, strconv.Itoa(value))
+			}
+		}
+
+		i = j + 1
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// cronStep reports the step of at least three values that are evenly
+// spaced by more than 1.
+func cronStep(values []int) (int, bool) {
+	if len(values) < 3 {
+		return 0, false
+	}
+
+	step := values[1] - values[0]
+	if step < 2 {
+		return 0, false
+	}
+
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return 0, false
+		}
+	}
+
+	return step, true
+}
+
+// splitCommand splits a command line into words like a shell, honouring
+// quotes and backslashes. It reports false if the command uses syntax only
+// a shell can run, such as pipes, redirections, variables and globs.
+func splitCommand(command string) ([]string, bool) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, c := range command {
+		switch {
+		case escaped:
+			// within double quotes a backslash only escapes what is special there
+			if quote == '"' && !strings.ContainsRune("\\\"$`", c) {
+				word.WriteRune('\\')
+			}
+
+			word.WriteRune(c)
+			escaped = false
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+		case quote == '"':
+			switch c {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			case '$', '`':
+				return nil, false
+			default:
+				word.WriteRune(c)
+			}
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case c == '\'' || c == '"':
+			quote, inWord = c, true
+		case c == '\\':
+			escaped, inWord = true, true
+		case strings.ContainsRune("|&;<>()$`*?[]{}\n", c),
+			!inWord && (c == '#' || c == '~'),
+			c == '=' && len(words) == 0:
+			return nil, false
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, false
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, len(words) > 0
+}
+
+// isResourceName reports whether name is a valid CronJob name.
+func isResourceName(name string) bool {
+	if name == "" || len(name) > maxCronJobName || name[0] == '-' || name[len(name)-1] == '-' {
+		return false
+	}
+
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// encodeYAML writes documents as a YAML stream indented the way kubectl
+// does.
+func encodeYAML(documents ...interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	for _, document := range documents {
+		if err := encoder.Encode(document); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,238 @@
+package export
+
+import (
+	"errors"
+	"github.com/cronparser/cron"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKubernetes(t *testing.T) {
+	crontab := parseCrontab(t, cron.New(), `
+CRON_TZ=Europe/Berlin
+PATH=/usr/local/bin:/usr/bin
+*/15 9-17 * * 1-5 /usr/bin/report --title "daily report" 'a b'
+`)
+
+	manifest, err := Kubernetes("report", crontab.Entries[0], KubernetesOptions{Image: "registry.example.com/report:1.2", Namespace: "batch"})
+	assert.Nil(t, err)
+	assert.Equal(t, `apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: report
+  namespace: batch
+spec:
+  schedule: '*/15 9-17 * * 1-5'
+  timeZone: Europe/Berlin
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+            - name: job
+              image: registry.example.com/report:1.2
+              command:
+                - /usr/bin/report
+              args:
+                - --title
+                - daily report
+                - a b
+              env:
+                - name: PATH
+                  value: /usr/local/bin:/usr/bin
+          restartPolicy: OnFailure
+`, string(manifest))
+}
+
+func TestKubernetesSchedule(t *testing.T) {
+	tests := []struct {
+		msg         string
+		cron        *cron.Parser
+		input       string
+		expSchedule string
+	}{
+		{"lists and steps", cron.New(), "5,35 0/6 1,15 */3 *", "5,35 */6 1,15 */3 *"},
+		{"steps that stop early", cron.New(), "10-50/20 * * * *", "10-50/20 * * * *"},
+		{"either day", cron.New(), "0 0 13 * 5", "0 0 13 * 5"},
+		{"either day with every day of month", cron.New(), "0 0 1-31 * 5", "0 0 * * *"},
+		{"no specific day of week", cron.New(), "0 0 13 * ?", "0 0 13 * *"},
+		{"sunday as 7", cron.New(), "0 0 * * 7", "0 0 * * 0"},
+		{"macro", cron.New(), "@weekly", "0 0 * * 0"},
+		{"hash", cron.New(cron.WithHashSeed("backup")), "H 2 * * *", "16 2 * * *"},
+		{"wrap around", cron.New(cron.WithWrapAround()), "0 22-2 * * *", "0 0-2,22,23 * * *"},
+		{"zero seconds", cron.New(cron.WithSeconds()), "0 30 9 * * *", "30 9 * * *"},
+		{"quartz", cron.New(cron.WithDialect(cron.Quartz)), "0 0 12 ? * MON-FRI", "0 12 * * 1-5"},
+		{"aws", cron.New(cron.WithDialect(cron.AWS)), "cron(0 12 1 * ? *)", "0 12 1 * *"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := test.cron.ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			expr, err := kubernetesSchedule(schedule)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expSchedule, expr)
+		})
+	}
+}
+
+func TestKubernetesScheduleErrors(t *testing.T) {
+	tests := []struct {
+		msg      string
+		cron     *cron.Parser
+		input    string
+		expError string
+	}{
+		{"seconds", cron.New(cron.WithSeconds()), "*/10 * * * * *", "schedule cannot be represented exactly: Kubernetes schedules have no seconds field, got */10"},
+		{"year", cron.New(cron.WithYear()), "0 0 1 1 * 2027", "schedule cannot be represented exactly: Kubernetes schedules have no year field, got 2027"},
+		{"year step", cron.New(cron.WithYear()), "0 0 1 * * */2", "schedule cannot be represented exactly: Kubernetes schedules have no year field, got */2"},
+		{"last day of the month", cron.New(), "0 0 L * *", "schedule cannot be represented exactly: L in the day of month field is not supported by Kubernetes"},
+		{"nearest weekday", cron.New(), "0 0 15W * *", "schedule cannot be represented exactly: 15W in the day of month field is not supported by Kubernetes"},
+		{"nth weekday", cron.New(), "0 0 * * 1#2", "schedule cannot be represented exactly: 1#2 in the day of week field is not supported by Kubernetes"},
+		{"last weekday", cron.New(), "0 0 * * 5L", "schedule cannot be represented exactly: 5L in the day of week field is not supported by Kubernetes"},
+		{"reboot", cron.New(), "@reboot", "schedule cannot be represented exactly: @reboot is not supported by Kubernetes"},
+		{"interval", cron.New(), "@every 1h", "schedule cannot be represented exactly: @every and rate() are not supported by Kubernetes"},
+		{"both days", cron.New(cron.WithDayMatching(cron.DayMatchAnd)), "0 0 1-7 * 1", "schedule cannot be represented exactly: Kubernetes fires when either day field matches, the schedule needs both"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := test.cron.ParseExpression(test.input)
+			assert.Nil(t, err)
+
+			_, err = kubernetesSchedule(schedule)
+
+			assert.ErrorIs(t, err, cron.ErrNotRepresentable)
+			assert.EqualError(t, err, test.expError)
+		})
+	}
+}
+
+func TestSplitCommand(t *testing.T) {
+	tests := []struct {
+		input    string
+		expWords []string
+		expOK    bool
+	}{
+		{"/usr/bin/find /tmp -mtime +7 -delete", []string{"/usr/bin/find", "/tmp", "-mtime", "+7", "-delete"}, true},
+		{`echo "a b" 'c d' e\ f`, []string{"echo", "a b", "c d", "e f"}, true},
+		{`echo "a\"b" "c\d"`, []string{"echo", `a"b`, `c\d`}, true},
+		{"backup --to=/srv/backups", []string{"backup", "--to=/srv/backups"}, true},
+		{"find /tmp | wc -l", nil, false},
+		{"backup > /dev/null 2>&1", nil, false},
+		{"echo $HOME", nil, false},
+		{`echo "$HOME"`, nil, false},
+		{"rm /tmp/*.log", nil, false},
+		{"cd /srv && make", nil, false},
+		{"ls ~/backups", nil, false},
+		{"LANG=C date", nil, false},
+		{`echo "unterminated`, nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			words, ok := splitCommand(test.input)
+
+			assert.Equal(t, test.expOK, ok)
+			assert.Equal(t, test.expWords, words)
+		})
+	}
+}
+
+func TestKubernetesCrontab(t *testing.T) {
+	crontab := parseCrontab(t, cron.New(), `SHELL=/bin/bash
+0 2 * * * pg_dump app | gzip > /backups/app.sql.gz
+0 0 L * * /usr/bin/invoice
+@hourly /usr/bin/poll
+`)
+
+	manifests, err := KubernetesCrontab("app", crontab, KubernetesOptions{Image: "app:latest"})
+
+	var crontabErr *cron.CrontabError
+	assert.True(t, errors.As(err, &crontabErr))
+	assert.EqualError(t, err, "line 3: schedule cannot be represented exactly: L in the day of month field is not supported by Kubernetes")
+
+	assert.Equal(t, `apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: app-2
+spec:
+  schedule: 0 2 * * *
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+            - name: job
+              image: app:latest
+              command:
+                - /bin/bash
+              args:
+                - -c
+                - pg_dump app | gzip > /backups/app.sql.gz
+              env:
+                - name: SHELL
+                  value: /bin/bash
+          restartPolicy: OnFailure
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: app-4
+spec:
+  schedule: 0 * * * *
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+            - name: job
+              image: app:latest
+              command:
+                - /usr/bin/poll
+              env:
+                - name: SHELL
+                  value: /bin/bash
+          restartPolicy: OnFailure
+`, string(manifests))
+}
+
+func TestKubernetesReferences(t *testing.T) {
+	crontab := parseCrontab(t, cron.New(), `GREETING=hello $(USER)
+0 0 * * * echo "$(date)" $$ $PATH
+`)
+
+	manifest, err := Kubernetes("greet", crontab.Entries[0], KubernetesOptions{Image: "busybox"})
+	assert.Nil(t, err)
+
+	// the kubelet turns $$ into $ and leaves the shell the original command
+	assert.Contains(t, string(manifest), `
+              args:
+                - -c
+                - echo "$$(date)" $$$$ $PATH
+              env:
+                - name: GREETING
+                  value: hello $$(USER)
+`)
+}
+
+func TestKubernetesErrors(t *testing.T) {
+	schedule, err := cron.New().Parse("0 0 * * * /bin/true")
+	assert.Nil(t, err)
+
+	entry := cron.Entry{Line: 1, Schedule: schedule}
+
+	_, err = Kubernetes("Nightly_Job", entry, KubernetesOptions{Image: "busybox"})
+	assert.EqualError(t, err, `invalid CronJob name "Nightly_Job", expected at most 52 lowercase letters, digits and -`)
+
+	_, err = Kubernetes("nightly", entry, KubernetesOptions{})
+	assert.EqualError(t, err, "a container image is required")
+
+	schedule, err = cron.New(cron.WithSystemCrontab()).Parse("0 0 * * * root /bin/true")
+	assert.Nil(t, err)
+
+	_, err = Kubernetes("nightly", cron.Entry{Line: 1, Schedule: schedule}, KubernetesOptions{Image: "busybox"})
+	assert.EqualError(t, err, "schedule cannot be represented exactly: Kubernetes runs the command as the user of the image, not root")
+}
@@ -0,0 +1,207 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cronparser/cron"
+)
+
+// defaultShell is the shell cron runs commands with unless SHELL is set.
+const defaultShell = "/bin/sh"
+
+var (
+	// execEscaper quotes a command line for ExecStart=, where systemd
+	// expands $VAR and % specifiers before the shell sees it.
+	execEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `$$`, `%`, `%%`)
+
+	// environmentEscaper quotes an assignment for Environment=, which
+	// expands % specifiers but not variables.
+	environmentEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `%`, `%%`)
+
+	// specifierEscaper protects free text such as Description= from %
+	// specifier expansion.
+	specifierEscaper = strings.NewReplacer(`%`, `%%`)
+)
+
+// SystemdUnits is the pair of unit files that runs a crontab entry under
+// systemd.
+type SystemdUnits struct {
+	// Name is the unit name without its suffix, the files are Name+".timer"
+	// and Name+".service".
+	Name string
+	// Timer is the content of the .timer unit.
+	Timer string
+	// Service is the content of the .service unit the timer starts.
+	Service string
+}
+
+// Systemd converts a crontab entry into a timer and the oneshot service it
+// starts, both called name. The schedule becomes the OnCalendar= lines of
+// the timer, or OnBootSec=0 for @reboot, and its time zone is part of every
+// OnCalendar= line. The service runs the command with SHELL, /bin/sh by
+// default, as cron does, with the environment of the entry except CRON_TZ.
+// It runs as the user of a system crontab entry, or else as user, the owner
+// of the crontab. Without either it would run as root, so it is refused.
+//
+// Intervals, the W forms and commands that pass standard input with % have
+// no exact systemd equivalent and return an error wrapping
+// cron.ErrNotRepresentable.
+func Systemd(name, user string, entry cron.Entry) (*SystemdUnits, error) {
+	if !isUnitName(name) {
+		return nil, fmt.Errorf("invalid unit name %q", name)
+	}
+
+	schedule := entry.Schedule
+
+	if schedule.User() != "" {
+		user = schedule.User()
+	}
+
+	if user == "" {
+		return nil, errors.New("no user to run the command as, pass the owner of the crontab")
+	}
+
+	command, err := cronCommand(schedule.Command())
+	if err != nil {
+		return nil, err
+	}
+
+	triggers, err := systemdTriggers(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	description := "Description=" + specifierEscaper.Replace(command) + "\n"
+
+	var timer strings.Builder
+
+	timer.WriteString("[Unit]\n" + description + "\n[Timer]\n")
+
+	for _, trigger := range triggers {
+		timer.WriteString(trigger + "\n")
+	}
+
+	// timers are coalesced within a minute by default, cron is not
+	timer.WriteString("AccuracySec=1s\n\n[Install]\nWantedBy=timers.target\n")
+
+	var service strings.Builder
+
+	service.WriteString("[Unit]\n" + description + "\n[Service]\nType=oneshot\n")
+
+	service.WriteString("User=" + user + "\n")
+
+	for _, assignment := range environment(entry.Env) {
+		service.WriteString(`Environment="` + environmentEscaper.Replace(assignment) + "\"\n")
+	}
+
+	shell := defaultShell
+	if entry.Env["SHELL"] != "" {
+		shell = entry.Env["SHELL"]
+	}
+
+	service.WriteString("ExecStart=" + shell + ` -c "` + execEscaper.Replace(command) + "\"\n")
+
+	return &SystemdUnits{Name: name, Timer: timer.String(), Service: service.String()}, nil
+}
+
+// SystemdCrontab converts every entry of a crontab owned by user with
+// Systemd, naming the units after prefix and the line number of the entry,
+// e.g. "alice-12". The user may be empty for system crontabs.
+// Entries that cannot be converted are reported together as a
+// *cron.CrontabError, alongside the units of the others.
+func SystemdCrontab(prefix, user string, crontab *cron.Crontab) ([]*SystemdUnits, error) {
+	var (
+		units   []*SystemdUnits
+		invalid []*cron.LineError
+	)
+
+	for _, entry := range crontab.Entries {
+		unit, err := Systemd(fmt.Sprintf("%s-%d", prefix, entry.Line), user, entry)
+		if err != nil {
+			invalid = append(invalid, &cron.LineError{Line: entry.Line, Err: err})
+			continue
+		}
+
+		units = append(units, unit)
+	}
+
+	if len(invalid) > 0 {
+		return units, &cron.CrontabError{Errors: invalid}
+	}
+
+	return units, nil
+}
+
+// systemdTriggers returns the [Timer] settings that fire when the schedule
+// does.
+func systemdTriggers(schedule *cron.Schedule) ([]string, error) {
+	if schedule.Kind() == cron.KindReboot {
+		return []string{"OnBootSec=0"}, nil
+	}
+
+	lines, err := schedule.OnCalendar()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, line := range lines {
+		lines[i] = "OnCalendar=" + line
+	}
+
+	return lines, nil
+}
+
+// cronCommand returns the command line cron hands to the shell. Cron turns
+// \% into % and sends everything after an unescaped % to the command as
+// standard input, which is refused.
+func cronCommand(command string) (string, error) {
+	var result strings.Builder
+
+	for i := 0; i < len(command); i++ {
+		switch {
+		case strings.HasPrefix(command[i:], `\%`):
+			result.WriteByte('%')
+			i++
+		case command[i] == '%':
+			return "", fmt.Errorf("%w: the command passes standard input with %%: %s", cron.ErrNotRepresentable, command)
+		default:
+			result.WriteByte(command[i])
+		}
+	}
+
+	return result.String(), nil
+}
+
+// environment returns the NAME=value assignments of env sorted by name,
+// leaving out CRON_TZ, which the timer takes care of.
+func environment(env map[string]string) []string {
+	var assignments []string
+
+	for name, value := range env {
+		if name != "CRON_TZ" {
+			assignments = append(assignments, name+"="+value)
+		}
+	}
+
+	sort.Strings(assignments)
+
+	return assignments
+}
+
+// isUnitName reports whether name can be used as a systemd unit name.
+func isUnitName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune(":_.-", c)) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,130 @@
+package export
+
+import (
+	"errors"
+	"github.com/cronparser/cron"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestSystemd(t *testing.T) {
+	crontab := parseCrontab(t, cron.New(), `
+SHELL=/bin/bash
+PATH="/usr/local/bin:/usr/bin:/bin"
+CRON_TZ=Europe/Berlin
+30 2 * * 1-5 /usr/bin/backup --to "$HOME/backups" > /tmp/backup-$(date +\%F).log
+`)
+
+	units, err := SystemdCrontab("alice", "alice", crontab)
+	assert.Nil(t, err)
+	assert.Len(t, units, 1)
+
+	assert.Equal(t, "alice-5", units[0].Name)
+	assert.Equal(t, `[Unit]
+Description=/usr/bin/backup --to "$HOME/backups" > /tmp/backup-$(date +%%F).log
+
+[Timer]
+OnCalendar=Mon..Fri *-*-* 02:30:00 Europe/Berlin
+AccuracySec=1s
+
+[Install]
+WantedBy=timers.target
+`, units[0].Timer)
+	assert.Equal(t, `[Unit]
+Description=/usr/bin/backup --to "$HOME/backups" > /tmp/backup-$(date +%%F).log
+
+[Service]
+Type=oneshot
+User=alice
+Environment="PATH=/usr/local/bin:/usr/bin:/bin"
+Environment="SHELL=/bin/bash"
+ExecStart=/bin/bash -c "/usr/bin/backup --to \"$$HOME/backups\" > /tmp/backup-$$(date +%%F).log"
+`, units[0].Service)
+}
+
+func TestSystemdTriggers(t *testing.T) {
+	tests := []struct {
+		msg         string
+		line        string
+		expTriggers []string
+	}{
+		{"either day", "0 0 13 * 5 /bin/true", []string{"OnCalendar=Fri *-*-* 00:00:00", "OnCalendar=*-*-13 00:00:00"}},
+		{"macro", "@weekly /bin/true", []string{"OnCalendar=Sun *-*-* 00:00:00"}},
+		{"reboot", "@reboot /bin/true", []string{"OnBootSec=0"}},
+		{"time zone prefix", "CRON_TZ=UTC 0 12 * * * /bin/true", []string{"OnCalendar=*-*-* 12:00:00 UTC"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schedule, err := cron.New().Parse(test.line)
+			assert.Nil(t, err)
+
+			units, err := Systemd("job", "alice", cron.Entry{Line: 1, Schedule: schedule})
+			assert.Nil(t, err)
+
+			for _, trigger := range test.expTriggers {
+				assert.Contains(t, units.Timer, "\n"+trigger+"\n")
+			}
+			assert.Equal(t, len(test.expTriggers), strings.Count(units.Timer, "\nOn"))
+		})
+	}
+}
+
+func TestSystemdUser(t *testing.T) {
+	crontab := parseCrontab(t, cron.New(cron.WithSystemCrontab()), "0 4 * * * root /usr/sbin/logrotate /etc/logrotate.conf\n")
+
+	// the user column of a system crontab wins over the owner
+	units, err := SystemdCrontab("logrotate", "alice", crontab)
+	assert.Nil(t, err)
+	assert.Equal(t, `[Unit]
+Description=/usr/sbin/logrotate /etc/logrotate.conf
+
+[Service]
+Type=oneshot
+User=root
+ExecStart=/bin/sh -c "/usr/sbin/logrotate /etc/logrotate.conf"
+`, units[0].Service)
+
+	// a user crontab runs as its owner
+	crontab = parseCrontab(t, cron.New(), "0 4 * * * /usr/bin/backup\n")
+
+	units, err = SystemdCrontab("alice", "alice", crontab)
+	assert.Nil(t, err)
+	assert.Contains(t, units[0].Service, "\nUser=alice\n")
+
+	// without an owner it would run as root
+	_, err = SystemdCrontab("alice", "", crontab)
+	assert.EqualError(t, err, "line 1: no user to run the command as, pass the owner of the crontab")
+}
+
+func TestSystemdErrors(t *testing.T) {
+	crontab := parseCrontab(t, cron.New(), `0 0 * * * /bin/true
+@every 90m /usr/bin/poll
+0 0 15W * * /usr/bin/report
+0 9 * * * mail -s report ops%Report attached
+`)
+
+	units, err := SystemdCrontab("jobs", "alice", crontab)
+	assert.Len(t, units, 1)
+	assert.Equal(t, "jobs-1", units[0].Name)
+
+	var crontabErr *cron.CrontabError
+	assert.True(t, errors.As(err, &crontabErr))
+	assert.ErrorIs(t, crontabErr.Errors[0], cron.ErrNotRepresentable)
+	assert.EqualError(t, err, `line 2: schedule cannot be represented exactly: interval schedules have no OnCalendar form
+line 3: schedule cannot be represented exactly: OnCalendar has no equivalent of 15W
+line 4: schedule cannot be represented exactly: the command passes standard input with %: mail -s report ops%Report attached`)
+
+	_, err = Systemd("my job", "alice", crontab.Entries[0])
+	assert.EqualError(t, err, `invalid unit name "my job"`)
+}
+
+func parseCrontab(t *testing.T, parser *cron.Parser, text string) *cron.Crontab {
+	t.Helper()
+
+	crontab, err := parser.ParseCrontab(strings.NewReader(text))
+	assert.Nil(t, err)
+
+	return crontab
+}